@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"sync"
 	"time"
 
 	fmgclient "github.com/fortinetdev/forti-sdk-go/fortimanager/sdkcore"
@@ -31,6 +32,14 @@ type Config struct {
 	FMG_Insecure *bool
 	FMG_CABundle string
 
+	FMG_SessionCache string
+	FMG_Token        string
+
+	FMG_MaxConcurrentRequests int
+
+	FMG_ProxyTarget string
+	FMG_ProxyAdom   string
+
 	PeerAuth   string
 	CaCert     string
 	ClientCert string
@@ -39,6 +48,16 @@ type Config struct {
 	PassAuth string
 	Username string
 	Passwd   string
+
+	ListPageSize               int
+	SchemaValidation           bool
+	UnsupportedAttributes      string
+	VersionCheck               string
+	AllowManagementLockout     bool
+	ConfigRevertTimeout        int
+	HASyncTimeout              int
+	FullUpdateResources        []string
+	OverwriteConcurrentChanges bool
 }
 
 // FortiClient contains the basic FortiOS SDK connection information to FortiOS
@@ -50,6 +69,100 @@ type FortiClient struct {
 	//to sdk client
 	Client             *forticlient.FortiSDKClient
 	ClientFortimanager *fmgclient.FmgSDKClient
+
+	// FMGSessionCache is the file keeping the FortiManager sessions between
+	// the runs of the provider, the sessions are logged out if it is empty
+	FMGSessionCache string
+
+	// FMGProxyTarget is the FortiGate, as "adom/<adom>/device/<name>", the
+	// FortiOS requests are sent to through FortiManager, empty when the
+	// FortiGate is reached directly
+	FMGProxyTarget string
+
+	fmgWorkspaceGlobal interface{}
+	fmgWorkspaceMode   map[string]bool
+	fmgAdomWrites      map[string]int
+	fmgAdomChanged     map[string]bool
+	fmgWorkspaceLock   sync.Mutex
+
+	// fmgDynamicMappingLock serializes the changes of the per-device values
+	// of the FortiManager objects, which are written as a whole
+	fmgDynamicMappingLock sync.Mutex
+
+	// cmdbReads keeps the objects read by the generated resources for the
+	// checks wrapped around them
+	cmdbReads *cmdbReadTransport
+
+	// ListPageSize is the page size used by GenericGroupRead
+	ListPageSize int
+
+	// VersionCheck is the action on the configurations not supported by
+	// the FortiOS version of the FortiGate: error, warning or disable
+	VersionCheck string
+
+	// SchemaValidation enables the plan time checks against the CMDB schema
+	SchemaValidation bool
+	cmdbSchemas      map[string]*cmdbTableSchema
+	cmdbSchemaReads  map[string]*cmdbSchemaRead
+	cmdbSchemasLock  sync.Mutex
+
+	// UnsupportedAttributes is the action on the arguments missing from the
+	// CMDB schema: error, warning or disable
+	UnsupportedAttributes string
+
+	// AllowManagementLockout disables the checks of the changes that can cut
+	// the provider off the FortiGate
+	AllowManagementLockout bool
+	managementConns        *managementConnsTransport
+	managementTarget       *managementTarget
+	managementTargetDone   bool
+	managementTargetLock   sync.Mutex
+
+	// ConfigRevertTimeout is the cfg-revert-timeout of the writes, 0 if they
+	// are not run under the configuration revert mode
+	ConfigRevertTimeout int
+	configRevertMode    string
+	configRevertSaved   time.Time
+	configRevertWrites  int
+	configRevertErr     error
+	configRevertLock    sync.Mutex
+
+	// HASyncTimeout is the time the writes wait for the members of an HA
+	// cluster to synchronize, 0 not to wait
+	HASyncTimeout int
+	haMode        string
+	haModeLock    sync.Mutex
+
+	// FullUpdateResources are the resources whose updates send the whole
+	// object instead of the changed attributes only
+	FullUpdateResources map[string]bool
+
+	// OverwriteConcurrentChanges disables the checks of the changes made by
+	// others to the objects since the plan
+	OverwriteConcurrentChanges bool
+}
+
+// fortiClients are the clients created by the provider
+var fortiClients struct {
+	sync.Mutex
+	l []*FortiClient
+}
+
+// CloseClients releases the clients created by the provider, it is called
+// when the provider exits
+func CloseClients() {
+	fortiClients.Lock()
+	defer fortiClients.Unlock()
+
+	for _, f := range fortiClients.l {
+		f.close()
+	}
+	fortiClients.l = nil
+}
+
+func (f *FortiClient) close() {
+	f.closeConfigRevert()
+	f.closeFortiManager()
 }
 
 // CreateClient creates a FortiClient Object with the authentication information.
@@ -57,9 +170,26 @@ type FortiClient struct {
 func (c *Config) CreateClient() (interface{}, error) {
 	var fClient FortiClient
 
+	fClient.ListPageSize = c.ListPageSize
+	fClient.SchemaValidation = c.SchemaValidation
+	fClient.UnsupportedAttributes = c.UnsupportedAttributes
+	fClient.VersionCheck = c.VersionCheck
+	fClient.AllowManagementLockout = c.AllowManagementLockout
+	fClient.ConfigRevertTimeout = c.ConfigRevertTimeout
+	fClient.HASyncTimeout = c.HASyncTimeout
+	fClient.OverwriteConcurrentChanges = c.OverwriteConcurrentChanges
+	fClient.FullUpdateResources = make(map[string]bool)
+	for _, r := range c.FullUpdateResources {
+		fClient.FullUpdateResources[r] = true
+	}
+
 	bFOSExist := bFortiOSHostnameExist(c)
 	bFMGExist := bFortiManagerHostnameExist(c)
 
+	if bFOSExist && c.FMG_ProxyTarget != "" {
+		return nil, fmt.Errorf("hostname and fmg_proxy_target could not be set at the same time")
+	}
+
 	if bFOSExist {
 		err := createFortiOSClient(&fClient, c)
 		if err != nil {
@@ -76,10 +206,24 @@ func (c *Config) CreateClient() (interface{}, error) {
 		fClient.ClientFortimanager = fmgclient.NewEmptyClient()
 	}
 
+	if c.FMG_ProxyTarget != "" {
+		if !bFMGExist {
+			return nil, fmt.Errorf("fmg_proxy_target needs the FortiManager to be configured")
+		}
+		err := createFortiOSProxyClient(&fClient, c)
+		if err != nil {
+			return nil, fmt.Errorf("Error create fortios client: %v", err)
+		}
+	}
+
 	if !bFOSExist && !bFMGExist {
 		return nil, fmt.Errorf("FortiOS or FortiManager, at least one of their hostnames should be set")
 	}
 
+	fortiClients.Lock()
+	fortiClients.l = append(fortiClients.l, &fClient)
+	fortiClients.Unlock()
+
 	return &fClient, nil
 }
 
@@ -257,8 +401,10 @@ func createFortiOSClient(fClient *FortiClient, c *Config) error {
 		tr.Proxy = http.ProxyURL(httpProxy)
 	}
 
+	fClient.managementConns = &managementConnsTransport{base: tr}
+	fClient.cmdbReads = &cmdbReadTransport{base: fClient.managementConns}
 	client := &http.Client{
-		Transport: tr,
+		Transport: fClient.cmdbReads,
 		Timeout:   time.Second * 250,
 	}
 
@@ -286,8 +432,14 @@ func createFortiManagerClient(fClient *FortiClient, c *Config) error {
 	if c.FMG_CABundle == "" {
 		c.FMG_CABundle = os.Getenv("FORTIOS_FMG_CABUNDLE")
 	}
-	if c.FMG_Hostname == "" || c.FMG_Username == "" || c.FMG_Passwd == "" {
-		return fmt.Errorf("Error: hostname, username and passwd are needed here for fortimanager")
+	if c.FMG_SessionCache == "" {
+		c.FMG_SessionCache = os.Getenv("FORTIOS_FMG_SESSION_CACHE")
+	}
+	if c.FMG_Token == "" {
+		c.FMG_Token = os.Getenv("FORTIOS_FMG_TOKEN")
+	}
+	if c.FMG_Hostname == "" || (c.FMG_Token == "" && (c.FMG_Username == "" || c.FMG_Passwd == "")) {
+		return fmt.Errorf("Error: hostname, and token or username and passwd are needed here for fortimanager")
 	}
 
 	config := &tls.Config{}
@@ -331,16 +483,29 @@ func createFortiManagerClient(fClient *FortiClient, c *Config) error {
 		TLSClientConfig: config,
 	}
 
+	ft := newFMGTransport(tr, c.FMG_Token, c.FMG_MaxConcurrentRequests)
+
 	client := &http.Client{
-		Transport: tr,
+		Transport: ft,
 	}
 	fClient.ClientFortimanager = fmgclient.NewClient(c.FMG_Hostname, c.FMG_Username, c.FMG_Passwd, client)
+	ft.client = fClient.ClientFortimanager
 
 	fClient.ClientFortimanager.DebugNum = 0
 
-	session, err := fClient.ClientFortimanager.Login()
-	if err != nil {
-		return fmt.Errorf("FortiManager Login failed")
+	fClient.FMGSessionCache = c.FMG_SessionCache
+
+	// the calls authenticated with an API key have no session
+	session := ""
+	if c.FMG_Token == "" && c.FMG_SessionCache != "" {
+		session = loadFmgSession(c.FMG_SessionCache, fClient.ClientFortimanager)
+	}
+	if c.FMG_Token == "" && session == "" {
+		var err error
+		session, err = fmgLogin(fClient.ClientFortimanager)
+		if err != nil {
+			return fmt.Errorf("FortiManager Login failed: %v", err)
+		}
 	}
 	fClient.ClientFortimanager.SessionString = session
 	fClient.ClientFortimanager.Init = true
@@ -0,0 +1,458 @@
+package fortios
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/fortinetdev/forti-sdk-go/fortios/request"
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultListPageSize is the default number of entries requested per page
+// when reading a whole CMDB table
+const defaultListPageSize = 1000
+
+// cmdbHTTPStatusErrors describes the HTTP status codes of the FortiOS API, as
+// the SDK reports them for the generated resources
+var cmdbHTTPStatusErrors = map[float64]string{
+	400: "Bad Request - Request cannot be processed by the API",
+	401: "Not Authorized - Request without successful login session",
+	403: "Forbidden - Request is missing CSRF token or administrator is missing access profile permissions",
+	404: "Resource Not Found - Unable to find the specified resource",
+	405: "Method Not Allowed - Specified HTTP method is not allowed for this resource",
+	413: "Request Entity Too Large - Request cannot be processed due to large entity",
+	424: "Failed Dependency - Fail dependency can be duplicate resource, missing required parameter, missing required attribute, invalid attribute value",
+	429: "Access temporarily blocked - Maximum failed authentications reached. The offended source is temporarily blocked for certain amount of time",
+	500: "Internal Server Error - Internal error when processing the request",
+}
+
+// cmdbErrorFormat returns the error of the FortiOS API response result, whose
+// body is body, nil if the request succeeded
+func cmdbErrorFormat(result map[string]interface{}, body string) error {
+	if result == nil || result["status"] == nil {
+		return fmt.Errorf("\n%v", body)
+	}
+	if result["status"] == "success" {
+		return nil
+	}
+
+	code, ok := result["http_status"].(float64)
+	if !ok {
+		return fmt.Errorf("\n%v", body)
+	}
+
+	msg, ok := cmdbHTTPStatusErrors[code]
+	if !ok {
+		msg = "Unknow Error"
+	}
+	err := fmt.Errorf("%s (%.0f)", msg, code)
+
+	if result["cli_error"] != nil {
+		err = fmt.Errorf("%v\nCli response: \n%v", err, result["cli_error"])
+	}
+
+	return err
+}
+
+// errCmdbPageRepeated stops the decoding of a page repeating the previous one
+var errCmdbPageRepeated = errors.New("page repeated")
+
+// cmdbDecodeStream decodes a FortiOS API response from r without buffering it,
+// calling fn for every entry of the "results" array as soon as it is decoded.
+// It returns the remaining top level fields and the number of entries found.
+func cmdbDecodeStream(r io.Reader, fn func(map[string]interface{}) error) (result map[string]interface{}, n int, err error) {
+	dec := json.NewDecoder(r)
+	result = make(map[string]interface{})
+
+	t, err := dec.Token()
+	if err != nil {
+		return nil, 0, fmt.Errorf("cannot decode response: %v", err)
+	}
+	if d, ok := t.(json.Delim); !ok || d != '{' {
+		return nil, 0, fmt.Errorf("cannot decode response: unexpected token %v", t)
+	}
+
+	for dec.More() {
+		t, err = dec.Token()
+		if err != nil {
+			return nil, n, fmt.Errorf("cannot decode response: %v", err)
+		}
+		key, _ := t.(string)
+
+		if key != "results" {
+			var v interface{}
+			if err = dec.Decode(&v); err != nil {
+				return nil, n, fmt.Errorf("cannot decode response field %s: %v", key, err)
+			}
+			result[key] = v
+			continue
+		}
+
+		t, err = dec.Token()
+		if err != nil {
+			return nil, n, fmt.Errorf("cannot decode response results: %v", err)
+		}
+		if d, ok := t.(json.Delim); !ok || d != '[' {
+			return nil, n, fmt.Errorf("cannot decode response results: expected a list, got %v", t)
+		}
+
+		for dec.More() {
+			var v map[string]interface{}
+			if err = dec.Decode(&v); err != nil {
+				return nil, n, fmt.Errorf("cannot decode response results: %v", err)
+			}
+			n++
+			if err = fn(v); err != nil {
+				return nil, n, err
+			}
+		}
+
+		if _, err = dec.Token(); err != nil {
+			return nil, n, fmt.Errorf("cannot decode response results: %v", err)
+		}
+	}
+
+	return result, n, nil
+}
+
+// cmdbGroupReadPage reads one page of the CMDB table at path and streams its
+// entries to fn. pageSize <= 0 reads the whole table in a single request.
+// It returns the number of entries in the page, or -1 if the table is not found.
+func cmdbGroupReadPage(c *forticlient.FortiSDKClient, path, specialparams, vdomparam string, start, pageSize int, fn func(map[string]interface{}) error) (n int, err error) {
+	params := specialparams
+	if pageSize > 0 {
+		if params != "" {
+			params += "&"
+		}
+		params += "start=" + strconv.Itoa(start) + "&count=" + strconv.Itoa(pageSize)
+	}
+
+	req := c.NewRequest("GET", path, nil, nil)
+	err = req.SendWithSpecialParams(params, vdomparam)
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %v", err)
+		return
+	}
+	defer req.HTTPResponse.Body.Close()
+
+	result, n, err := cmdbDecodeStream(req.HTTPResponse.Body, fn)
+	if err != nil {
+		return
+	}
+	log.Printf("FOS-fortios reading %s: %d entries from %d", path, n, start)
+
+	if result["http_status"] == 404.0 {
+		return -1, nil
+	}
+
+	if result["status"] != "success" {
+		body, _ := json.Marshal(result)
+		err = cmdbErrorFormat(result, string(body))
+	}
+
+	return
+}
+
+// GenericGroupRead reads all entries of the CMDB table at path. Tables are
+// fetched in pages of ListPageSize entries with the start/count parameters,
+// and each page is decoded entry by entry instead of being read into memory
+// as a whole, so large tables stay within the FortiGate's response limits.
+// It returns nil if the table is not found.
+func (f *FortiClient) GenericGroupRead(path, specialparams, vdomparam string) (mapTmp []interface{}, err error) {
+	mapTmp = []interface{}{}
+
+	found, err := f.genericGroupRead(path, specialparams, vdomparam, func(v map[string]interface{}) error {
+		mapTmp = append(mapTmp, v)
+		return nil
+	})
+	if err != nil || !found {
+		return nil, err
+	}
+
+	return
+}
+
+// GenericGroupReadEach is GenericGroupRead without collecting the results,
+// fn is called once for every entry of the table.
+func (f *FortiClient) GenericGroupReadEach(path, specialparams, vdomparam string, fn func(map[string]interface{}) error) error {
+	_, err := f.genericGroupRead(path, specialparams, vdomparam, fn)
+	return err
+}
+
+func (f *FortiClient) genericGroupRead(path, specialparams, vdomparam string, fn func(map[string]interface{}) error) (bool, error) {
+	c := f.Client
+	if c == nil {
+		return false, fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+
+	pageSize := f.ListPageSize
+	start := 0
+
+	// the first entry of the previous page, to detect the firmwares ignoring
+	// start and returning the same page again
+	var last map[string]interface{}
+
+	for {
+		var first map[string]interface{}
+
+		n, err := cmdbGroupReadPage(c, path, specialparams, vdomparam, start, pageSize, func(v map[string]interface{}) error {
+			if first == nil {
+				first = v
+				if last != nil && reflect.DeepEqual(v, last) {
+					return errCmdbPageRepeated
+				}
+			}
+			return fn(v)
+		})
+		if err == errCmdbPageRepeated {
+			return false, fmt.Errorf("cannot read %s page by page, the device ignores the start parameter, set list_page_size to 0 to read the table in a single request", path)
+		}
+		if err != nil {
+			return false, err
+		}
+
+		if n < 0 {
+			return start > 0, nil
+		}
+
+		// the firmwares ignoring count return the whole table at once
+		if pageSize <= 0 || n != pageSize {
+			return true, nil
+		}
+
+		last = first
+		start += n
+	}
+}
+
+// cmdbSend sends a request to the FortiOS API and returns the decoded
+// response. A missing object is reported as a nil result without error.
+func cmdbSend(c *forticlient.FortiSDKClient, method, path, specialparams, vdomparam string, data interface{}) (result map[string]interface{}, err error) {
+	var req *request.Request
+
+	if data != nil {
+		locJSON, err := json.Marshal(data)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode request: %v", err)
+		}
+		req = c.NewRequest(method, path, nil, bytes.NewBuffer(locJSON))
+	} else {
+		req = c.NewRequest(method, path, nil, nil)
+	}
+
+	err = req.SendWithSpecialParams(specialparams, vdomparam)
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %v", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	req.HTTPResponse.Body.Close()
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %v", err)
+		return
+	}
+	log.Printf("FOS-fortios %s %s response: %s", method, path, string(body))
+
+	json.Unmarshal(body, &result)
+
+	if result != nil && result["http_status"] == 404.0 {
+		return nil, nil
+	}
+
+	err = cmdbErrorFormat(result, string(body))
+	if err != nil {
+		result = nil
+	}
+
+	return
+}
+
+// monitorRead reads the monitor API endpoint at path, such as "system/status"
+func monitorRead(c *forticlient.FortiSDKClient, path, specialparams, vdomparam string) (map[string]interface{}, error) {
+	return cmdbSend(c, "GET", "/api/v2/monitor/"+strings.Trim(path, "/"), specialparams, vdomparam, nil)
+}
+
+// cmdbObjectPath builds the API path of a CMDB table or of one of its entries,
+// path is given relative to /api/v2/cmdb, such as "firewall/address".
+func cmdbObjectPath(path string, mkeys ...string) string {
+	p := "/api/v2/cmdb/" + strings.Trim(strings.TrimPrefix(path, "/api/v2/cmdb/"), "/")
+	for _, k := range mkeys {
+		// the parent of a sub-table of a singleton table has no key
+		if k != "" {
+			p += "/" + forticlient.EscapeURLString(k)
+		}
+	}
+	return p
+}
+
+// cmdbRead reads a CMDB object, or a singleton table when no mkey is given.
+// It returns nil if the object does not exist.
+func cmdbRead(c *forticlient.FortiSDKClient, path string, mkeys []string, vdomparam string) (map[string]interface{}, error) {
+	result, err := cmdbSend(c, "GET", cmdbObjectPath(path, mkeys...), "", vdomparam, nil)
+	if err != nil || result == nil {
+		return nil, err
+	}
+
+	switch r := result["results"].(type) {
+	case map[string]interface{}:
+		return r, nil
+	case []interface{}:
+		if len(r) == 0 {
+			return nil, nil
+		}
+		if o, ok := r[0].(map[string]interface{}); ok {
+			return o, nil
+		}
+	}
+
+	return nil, fmt.Errorf("cannot get the results from the response")
+}
+
+// cmdbCreateUpdate posts a new object to a CMDB table (method "POST"), or puts
+// the given attributes to an existing object or singleton table (method "PUT").
+// It returns the mkey reported by FortiOS.
+func cmdbCreateUpdate(c *forticlient.FortiSDKClient, method, path string, mkeys []string, obj map[string]interface{}, vdomparam string) (string, error) {
+	result, err := cmdbSend(c, method, cmdbObjectPath(path, mkeys...), "", vdomparam, obj)
+	if err != nil {
+		return "", err
+	}
+	if result == nil {
+		return "", fmt.Errorf("Resource Not Found - Unable to find the specified resource (404)")
+	}
+
+	if result["mkey"] != nil {
+		return fmt.Sprintf("%v", result["mkey"]), nil
+	}
+
+	return "", nil
+}
+
+// cmdbDelete deletes a CMDB object, deleting an object that no longer
+// exists is not an error.
+func cmdbDelete(c *forticlient.FortiSDKClient, path string, mkeys []string, vdomparam string) error {
+	_, err := cmdbSend(c, "DELETE", cmdbObjectPath(path, mkeys...), "", vdomparam, nil)
+	return err
+}
+
+// cmdbTableSchema describes a CMDB table as served by ?action=schema
+type cmdbTableSchema struct {
+	Name     string                     `json:"name"`
+	Category string                     `json:"category"`
+	Mkey     string                     `json:"mkey"`
+	MkeyType string                     `json:"mkey_type"`
+	Children map[string]*cmdbAttrSchema `json:"children"`
+}
+
+// cmdbAttrSchema describes an attribute or a sub-table of a CMDB table
+type cmdbAttrSchema struct {
+	Name           string                     `json:"name"`
+	Category       string                     `json:"category"`
+	Type           string                     `json:"type"`
+	Mkey           string                     `json:"mkey"`
+	MkeyType       string                     `json:"mkey_type"`
+	Size           int                        `json:"size"`
+	MinValue       *float64                   `json:"min-value"`
+	MaxValue       *float64                   `json:"max-value"`
+	MultipleValues bool                       `json:"multiple_values"`
+	Default        interface{}                `json:"default"`
+	Options        []cmdbAttrOption           `json:"options"`
+	Children       map[string]*cmdbAttrSchema `json:"children"`
+}
+
+type cmdbAttrOption struct {
+	Name string `json:"name"`
+}
+
+// errCmdbNoSchema is returned by cmdbReadSchema for the tables the FortiGate
+// serves no schema of
+var errCmdbNoSchema = errors.New("no schema found")
+
+// cmdbReadSchema reads the schema of the CMDB table at path from the device
+func cmdbReadSchema(c *forticlient.FortiSDKClient, path, vdomparam string) (*cmdbTableSchema, error) {
+	result, err := cmdbSend(c, "GET", cmdbObjectPath(path), "action=schema", vdomparam, nil)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || result["results"] == nil {
+		return nil, fmt.Errorf("%w for %s", errCmdbNoSchema, path)
+	}
+
+	b, err := json.Marshal(result["results"])
+	if err != nil {
+		return nil, err
+	}
+
+	s := &cmdbTableSchema{}
+	if err = json.Unmarshal(b, s); err != nil {
+		return nil, fmt.Errorf("cannot decode schema of %s: %v", path, err)
+	}
+
+	return s, nil
+}
+
+// isSingleton reports whether the table holds a single settings object
+// rather than a list of entries
+func (s *cmdbTableSchema) isSingleton() bool {
+	return s.Category == "complex"
+}
+
+// cmdbResource describes the CMDB table behind a generated resource
+type cmdbResource struct {
+	path      string
+	singleton bool
+}
+
+// contextCRUDFunc is the context aware form of the CRUD functions
+type contextCRUDFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+// contextFunc returns the context aware form of a CRUD function of a resource,
+// given as fn or ctxFn, nil if both are
+func contextFunc(fn func(*schema.ResourceData, interface{}) error, ctxFn contextCRUDFunc) contextCRUDFunc {
+	if ctxFn != nil || fn == nil {
+		return ctxFn
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		return diag.FromErr(fn(d, m))
+	}
+}
+
+// configureCmdbResource adds the plan time checks and the behaviours shared by
+// all the resources generated from the CMDB tables to r
+func configureCmdbResource(name string, r *schema.Resource, t cmdbResource) {
+	var funcs []schema.CustomizeDiffFunc
+	if r.CustomizeDiff != nil {
+		funcs = append(funcs, r.CustomizeDiff)
+	}
+	funcs = append(funcs,
+		cmdbVersionCustomizeDiff(name, r, t),
+		cmdbSchemaCustomizeDiff(r, t),
+		cmdbDefaultsCustomizeDiff(r, t),
+	)
+	if f := managementLockoutCustomizeDiff(name); f != nil {
+		funcs = append(funcs, f)
+	}
+	r.CustomizeDiff = customdiff.All(funcs...)
+
+	if r.Importer != nil {
+		r.Importer = &schema.ResourceImporter{
+			State: cmdbImportState(t),
+		}
+	}
+
+	configureCmdbDefaults(r, t)
+	configureCmdbRevision(name, r, t)
+}
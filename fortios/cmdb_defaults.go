@@ -0,0 +1,228 @@
+package fortios
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Most arguments are optional and computed: removing them from the
+// configuration leaves the value of the device in place. Each write records in
+// configured_attributes the arguments set in the configuration, and the plan
+// resets the ones removed since to the default of the CMDB schema of the
+// device. Destroying a singleton resets the arguments it configured.
+
+// configureCmdbDefaults adds the reset of the removed arguments to r
+func configureCmdbDefaults(r *schema.Resource, t cmdbResource) {
+	if r.Create == nil || r.Update == nil {
+		return
+	}
+
+	r.Schema["configured_attributes"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	create, update, del := r.Create, r.Update, r.Delete
+
+	r.Create = func(d *schema.ResourceData, m interface{}) error {
+		if err := create(d, m); err != nil {
+			return err
+		}
+		cmdbSaveConfiguredAttributes(d, r)
+		return nil
+	}
+
+	r.Update = func(d *schema.ResourceData, m interface{}) error {
+		if err := update(d, m); err != nil {
+			return err
+		}
+		cmdbSaveConfiguredAttributes(d, r)
+		return nil
+	}
+
+	if t.singleton && del != nil {
+		r.Delete = func(d *schema.ResourceData, m interface{}) error {
+			return cmdbResetSingleton(d, m, r, t, del)
+		}
+	}
+}
+
+// cmdbConfiguredAttributes returns the arguments of r set in the
+// configuration of d, false if the configuration is unknown
+func cmdbConfiguredAttributes(d interface{ GetRawConfig() cty.Value }, r *schema.Resource) ([]string, bool) {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() {
+		return nil, false
+	}
+
+	names := []string{}
+	for k, s := range r.Schema {
+		if cmdbLocalAttributes[k] || !(s.Optional || s.Required) || !config.Type().HasAttribute(k) {
+			continue
+		}
+		if !config.GetAttr(k).IsNull() {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+
+	return names, true
+}
+
+func cmdbSaveConfiguredAttributes(d *schema.ResourceData, r *schema.Resource) {
+	if d.Id() == "" {
+		return
+	}
+
+	names, ok := cmdbConfiguredAttributes(d, r)
+	if !ok {
+		return
+	}
+
+	d.Set("configured_attributes", names)
+}
+
+// cmdbDefaultsCustomizeDiff resets the optional and computed arguments
+// removed from the configuration since the last write to their default
+func cmdbDefaultsCustomizeDiff(r *schema.Resource, t cmdbResource) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		f, ok := m.(*FortiClient)
+		if !ok || f == nil || f.Client == nil || d.Id() == "" {
+			return nil
+		}
+
+		configured, _ := d.Get("configured_attributes").([]interface{})
+		if len(configured) == 0 {
+			return nil
+		}
+
+		l, ok := cmdbConfiguredAttributes(d, r)
+		if !ok {
+			return nil
+		}
+
+		current := make(map[string]bool)
+		for _, k := range l {
+			current[k] = true
+		}
+
+		var names map[string]*cmdbAttrSchema
+
+		for _, i := range configured {
+			k, _ := i.(string)
+			s, ok := r.Schema[k]
+			if !ok || current[k] || !s.Optional || !s.Computed {
+				continue
+			}
+
+			if names == nil {
+				ts := f.CmdbSchema(t.path, cmdbVdomparam(d))
+				if ts == nil {
+					return nil
+				}
+				names = cmdbAttrNames(ts.Children, r.Schema, true)
+			}
+
+			a, ok := names[k]
+			if !ok || a.Default == nil {
+				log.Printf("[WARN] no default found for %s of %s, it is left unchanged", k, t.path)
+				continue
+			}
+
+			v, ok := cmdbDefaultValue(s, a.Default)
+			if !ok {
+				continue
+			}
+
+			if o, _ := d.GetChange(k); fmt.Sprintf("%v", o) == fmt.Sprintf("%v", v) {
+				continue
+			}
+
+			log.Printf("[DEBUG] %s removed from the configuration of resource (%s), resetting it to %v", k, d.Id(), v)
+			if err := d.SetNew(k, v); err != nil {
+				return fmt.Errorf("cannot reset %s to its default: %v", k, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+// cmdbDefaultValue converts the default v of the CMDB schema to the type of
+// the argument s, only the scalar arguments are supported
+func cmdbDefaultValue(s *schema.Schema, v interface{}) (interface{}, bool) {
+	switch s.Type {
+	case schema.TypeString:
+		if n, ok := v.(float64); ok {
+			return strconv.FormatFloat(n, 'f', -1, 64), true
+		}
+		return fmt.Sprintf("%v", v), true
+	case schema.TypeInt:
+		switch n := v.(type) {
+		case float64:
+			return int(n), true
+		case string:
+			i, err := strconv.Atoi(n)
+			return i, err == nil
+		}
+	}
+
+	return nil, false
+}
+
+// cmdbResetSingleton puts the arguments the singleton of d configured back to
+// their defaults, del is used when they are unknown
+func cmdbResetSingleton(d *schema.ResourceData, m interface{}, r *schema.Resource, t cmdbResource, del schema.DeleteFunc) error {
+	f := m.(*FortiClient)
+
+	configured, _ := d.Get("configured_attributes").([]interface{})
+	if len(configured) == 0 || f.Client == nil {
+		return del(d, m)
+	}
+
+	vdomparam := cmdbVdomparam(d)
+
+	ts := f.CmdbSchema(t.path, vdomparam)
+	if ts == nil {
+		return del(d, m)
+	}
+	names := cmdbAttrNames(ts.Children, r.Schema, true)
+
+	obj := make(map[string]interface{})
+	for _, i := range configured {
+		k, _ := i.(string)
+		a, ok := names[k]
+		if !ok {
+			continue
+		}
+
+		switch {
+		case a.Category == "table":
+			obj[a.Name] = []interface{}{}
+		case a.Default != nil:
+			obj[a.Name] = a.Default
+		default:
+			log.Printf("[WARN] no default found for %s of %s, it is left unchanged", k, t.path)
+		}
+	}
+	if len(obj) == 0 {
+		return del(d, m)
+	}
+
+	if _, err := cmdbCreateUpdate(f.Client, "PUT", t.path, nil, obj, vdomparam); err != nil {
+		return fmt.Errorf("Error clearing %s resource: %v", d.Id(), err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
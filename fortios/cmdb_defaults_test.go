@@ -0,0 +1,35 @@
+package fortios
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCmdbDefaultValue(t *testing.T) {
+	cases := []struct {
+		typ  schema.ValueType
+		v    interface{}
+		want interface{}
+		ok   bool
+	}{
+		{schema.TypeString, "enable", "enable", true},
+		{schema.TypeString, "", "", true},
+		{schema.TypeString, float64(443), "443", true},
+		{schema.TypeString, float64(0.5), "0.5", true},
+		{schema.TypeString, float64(4294967295), "4294967295", true},
+		{schema.TypeInt, float64(3600), 3600, true},
+		{schema.TypeInt, "3600", 3600, true},
+		{schema.TypeInt, "disable", 0, false},
+		{schema.TypeInt, true, nil, false},
+		{schema.TypeBool, "enable", nil, false},
+		{schema.TypeList, []interface{}{}, nil, false},
+	}
+
+	for _, c := range cases {
+		got, ok := cmdbDefaultValue(&schema.Schema{Type: c.typ}, c.v)
+		if ok != c.ok || (ok && got != c.want) {
+			t.Errorf("cmdbDefaultValue(%v, %#v) = %#v, %v, want %#v, %v", c.typ, c.v, got, ok, c.want, c.ok)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package fortios
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The import ID of a CMDB resource is the key of the object, such as
+// "server1" or "5" for the tables keyed by an integer, optionally prefixed
+// with the vdom of the object as "vdom:mkey", "vdom:path/mkey" or "vdom/mkey",
+// path being the CMDB table of the resource such as "firewall/address". The
+// resources managing an entry of a sub-table of an object, such as the group
+// members, take the composite key "mkey/subkey" in the same forms, such as
+// "vdom1:firewall/addrgrp/grp1/addr1". The keys can contain slashes and
+// colons, such as "10.0.0.0/24" or IPv6 addresses, so the ID is first read as
+// a plain key, and "vdom/mkey" last. The vdom of the ID is set in vdomparam
+// and the object must exist.
+
+// cmdbImportVdom splits the vdom prefix "vdom:" of the import ID id, the vdom
+// names can't contain slashes so that the colons of the keys, such as IPv6
+// addresses, are not taken for the prefix
+func cmdbImportVdom(id string) (string, string, bool) {
+	i := strings.Index(id, ":")
+	if i <= 0 || strings.Contains(id[:i], "/") {
+		return "", id, false
+	}
+
+	return id[:i], id[i+1:], true
+}
+
+// cmdbImportCandidate is a possible reading of an import ID
+type cmdbImportCandidate struct {
+	vdom string
+	mkey string
+}
+
+// cmdbImportCandidates returns the readings of the import ID id for the table
+// t, the plain key first and "vdom/mkey" last. The singleton tables have no
+// key, their ID only tells the vdom and is read as a plain key last.
+func cmdbImportCandidates(id string, t cmdbResource) []cmdbImportCandidate {
+	var l []cmdbImportCandidate
+	if !t.singleton {
+		l = append(l, cmdbImportCandidate{"", id})
+	}
+
+	if vdom, rest, ok := cmdbImportVdom(id); ok {
+		if rest == t.path {
+			rest = ""
+		}
+		l = append(l, cmdbImportCandidate{vdom, strings.TrimPrefix(rest, t.path+"/")})
+	}
+
+	if i := strings.Index(id, "/"); i > 0 && !strings.Contains(id[:i], ":") {
+		l = append(l, cmdbImportCandidate{id[:i], id[i+1:]})
+	}
+
+	if t.singleton {
+		l = append(l, cmdbImportCandidate{"", id})
+	}
+
+	return l
+}
+
+// cmdbImportState returns the importer of the CMDB table t
+func cmdbImportState(t cmdbResource) schema.StateFunc {
+	return cmdbImportKeys(t, "mkey", func(mkey string) []string {
+		if t.singleton {
+			return []string{}
+		}
+		if mkey == "" {
+			return nil
+		}
+		return []string{mkey}
+	})
+}
+
+// cmdbImportKeys returns the importer of the objects of the CMDB table t
+// identified by the keys returned by keys for the key of a candidate reading
+// of the import ID, nil if the key can't be split. format names the key in
+// the error messages, such as "group/member".
+func cmdbImportKeys(t cmdbResource, format string, keys func(mkey string) []string) schema.StateFunc {
+	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		c := m.(*FortiClient).Client
+		if c == nil {
+			return nil, fmt.Errorf("Error importing %s: FortiOS connection did not initialize successfully", d.Id())
+		}
+
+		var lastErr error
+
+		for _, ic := range cmdbImportCandidates(d.Id(), t) {
+			mkeys := keys(ic.mkey)
+			if mkeys == nil {
+				continue
+			}
+
+			o, err := cmdbRead(c, t.path, mkeys, ic.vdom)
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			if o == nil {
+				continue
+			}
+
+			if ic.mkey != "" {
+				d.SetId(ic.mkey)
+			}
+			if ic.vdom != "" {
+				d.Set("vdomparam", ic.vdom)
+			}
+
+			return []*schema.ResourceData{d}, nil
+		}
+
+		if lastErr != nil {
+			return nil, fmt.Errorf("Error importing %s from %s: %v", d.Id(), t.path, lastErr)
+		}
+
+		return nil, fmt.Errorf("Error importing %s: object not found in %s, the import ID should be %[3]s, vdom:%[3]s, vdom:%[4]s/%[3]s or vdom/%[3]s", d.Id(), t.path, format, t.path)
+	}
+}
@@ -0,0 +1,95 @@
+package fortios
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCmdbImportCandidates(t *testing.T) {
+	table := cmdbResource{path: "firewall/address"}
+	group := cmdbResource{path: "firewall/addrgrp"}
+	singleton := cmdbResource{path: "system/global", singleton: true}
+
+	cases := []struct {
+		id   string
+		t    cmdbResource
+		want []cmdbImportCandidate
+	}{
+		{"server1", table, []cmdbImportCandidate{{"", "server1"}}},
+		{"10.0.0.0/24", table, []cmdbImportCandidate{{"", "10.0.0.0/24"}, {"10.0.0.0", "24"}}},
+		{"vdom1:server1", table, []cmdbImportCandidate{{"", "vdom1:server1"}, {"vdom1", "server1"}}},
+		{"vdom1/server1", table, []cmdbImportCandidate{{"", "vdom1/server1"}, {"vdom1", "server1"}}},
+		{"vdom1:firewall/address/10.0.0.0/24", table, []cmdbImportCandidate{{"", "vdom1:firewall/address/10.0.0.0/24"}, {"vdom1", "10.0.0.0/24"}}},
+		{"2001:db8::/64", table, []cmdbImportCandidate{{"", "2001:db8::/64"}, {"2001", "db8::/64"}}},
+		{"a/b:c", table, []cmdbImportCandidate{{"", "a/b:c"}, {"a", "b:c"}}},
+		{"grp1/addr1", group, []cmdbImportCandidate{{"", "grp1/addr1"}, {"grp1", "addr1"}}},
+		{"vdom1/grp1/addr1", group, []cmdbImportCandidate{{"", "vdom1/grp1/addr1"}, {"vdom1", "grp1/addr1"}}},
+		{"vdom1:firewall/addrgrp/grp1/10.0.0.0/24", group, []cmdbImportCandidate{{"", "vdom1:firewall/addrgrp/grp1/10.0.0.0/24"}, {"vdom1", "grp1/10.0.0.0/24"}}},
+		{"SystemGlobal", singleton, []cmdbImportCandidate{{"", "SystemGlobal"}}},
+		{"root:SystemGlobal", singleton, []cmdbImportCandidate{{"root", "SystemGlobal"}, {"", "root:SystemGlobal"}}},
+		{"root/SystemGlobal", singleton, []cmdbImportCandidate{{"root", "SystemGlobal"}, {"", "root/SystemGlobal"}}},
+		{"root:system/global", singleton, []cmdbImportCandidate{{"root", ""}, {"", "root:system/global"}}},
+	}
+
+	for _, c := range cases {
+		if got := cmdbImportCandidates(c.id, c.t); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("cmdbImportCandidates(%q, %s) = %v, want %v", c.id, c.t.path, got, c.want)
+		}
+	}
+}
+
+func TestCmdbImportKeys(t *testing.T) {
+	cases := []struct {
+		id        string
+		vdom      string
+		wantID    string
+		wantVdom  string
+		wantError bool
+	}{
+		{id: "grp1/addr1", wantID: "grp1/addr1"},
+		{id: "grp1/10.0.0.0/24", wantID: "grp1/10.0.0.0/24"},
+		{id: "vdom1/grp1/addr1", vdom: "vdom1", wantID: "grp1/addr1", wantVdom: "vdom1"},
+		{id: "vdom1:grp1/addr1", vdom: "vdom1", wantID: "grp1/addr1", wantVdom: "vdom1"},
+		{id: "vdom1:firewall/addrgrp/grp1/addr1", vdom: "vdom1", wantID: "grp1/addr1", wantVdom: "vdom1"},
+		{id: "grp1/addr2", wantError: true},
+		{id: "grp1", wantError: true},
+	}
+
+	for _, c := range cases {
+		_, member := resourceGroupMemberKey(c.wantID)
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			result := map[string]interface{}{"status": "success", "http_status": 404, "version": "v7.0.5", "serial": "FGVM"}
+			if r.URL.EscapedPath() == cmdbObjectPath("firewall/addrgrp", "grp1", "member", member) && r.URL.Query().Get("vdom") == c.vdom {
+				result["http_status"] = 200
+				result["results"] = []interface{}{map[string]interface{}{"name": member}}
+			}
+			json.NewEncoder(w).Encode(result)
+		}))
+
+		insecure := true
+		m, err := (&Config{Hostname: strings.TrimPrefix(server.URL, "https://"), Token: "x", Insecure: &insecure}).CreateClient()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		r := resourceGroupMember("firewall/addrgrp", "FirewallAddrgrpMember")
+		d := r.Data(nil)
+		d.SetId(c.id)
+
+		_, err = r.Importer.State(d, m)
+		server.Close()
+
+		switch {
+		case c.wantError && err == nil:
+			t.Errorf("%s: import = %s, want an error", c.id, d.Id())
+		case !c.wantError && err != nil:
+			t.Errorf("%s: import = %v, want no error", c.id, err)
+		case !c.wantError && (d.Id() != c.wantID || d.Get("vdomparam") != c.wantVdom):
+			t.Errorf("%s: import = %s in vdom %q, want %s in vdom %q", c.id, d.Id(), d.Get("vdomparam"), c.wantID, c.wantVdom)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package fortios
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The generated resources read their object at the end of their Create, Read
+// and Update. cmdbReadTransport keeps the responses of these reads for the
+// checks wrapped around the resources, so that they don't read the objects
+// once more.
+
+// cmdbReadTransport keeps the responses to the GET requests of the CMDB
+// tables some callers of cmdbReadObject wait for
+type cmdbReadTransport struct {
+	base http.RoundTripper
+
+	lock   sync.Mutex
+	tables map[string]*cmdbReadWait
+}
+
+// cmdbReadWait is the responses kept for the callers waiting for a table,
+// indexed by object
+type cmdbReadWait struct {
+	waiting int
+	bodies  map[string][]byte
+}
+
+// cmdbReadKey returns the key of the object at the API path path in vdom
+func cmdbReadKey(path, vdom string) string {
+	return strings.TrimSuffix(path, "/") + "?vdom=" + vdom
+}
+
+func (t *cmdbReadTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || req.Method != "GET" || req.URL.Query().Get("action") != "" {
+		return resp, err
+	}
+
+	t.lock.Lock()
+	w := t.wait(req.URL.Path)
+	t.lock.Unlock()
+	if w == nil {
+		return resp, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	t.lock.Lock()
+	w.bodies[cmdbReadKey(req.URL.Path, req.URL.Query().Get("vdom"))] = body
+	t.lock.Unlock()
+
+	return resp, nil
+}
+
+// wait returns the callers waiting for the table of the object at path, the
+// lock is held by the caller
+func (t *cmdbReadTransport) wait(path string) *cmdbReadWait {
+	for p := strings.TrimSuffix(path, "/"); strings.HasPrefix(p, "/api/v2/cmdb/"); {
+		if w, ok := t.tables[p]; ok {
+			return w
+		}
+		i := strings.LastIndex(p, "/")
+		if i < 0 {
+			break
+		}
+		p = p[:i]
+	}
+
+	return nil
+}
+
+// CloseIdleConnections closes the idle connections of the underlying
+// transport
+func (t *cmdbReadTransport) CloseIdleConnections() {
+	if c, ok := t.base.(interface{ CloseIdleConnections() }); ok {
+		c.CloseIdleConnections()
+	}
+}
+
+// cmdbReadObject runs fn, which calls the Create, Read or Update function of
+// the generated resource of the table t, and returns the response to the read
+// of the object of d these functions end with. The response is nil if the
+// object was not read or doesn't exist.
+func cmdbReadObject(d *schema.ResourceData, m interface{}, t cmdbResource, fn func()) map[string]interface{} {
+	f, ok := m.(*FortiClient)
+	if !ok || f == nil || f.Client == nil || f.cmdbReads == nil {
+		fn()
+		return nil
+	}
+
+	rt := f.cmdbReads
+	table := cmdbURLPath(cmdbObjectPath(t.path))
+
+	rt.lock.Lock()
+	if rt.tables == nil {
+		rt.tables = make(map[string]*cmdbReadWait)
+	}
+	w, ok := rt.tables[table]
+	if !ok {
+		w = &cmdbReadWait{bodies: make(map[string][]byte)}
+		rt.tables[table] = w
+	}
+	w.waiting++
+	rt.lock.Unlock()
+
+	fn()
+
+	var mkeys []string
+	if !t.singleton {
+		mkeys = []string{d.Id()}
+	}
+	vdom := cmdbVdomparam(d)
+	if vdom == "" && f.Client.Config.Auth != nil {
+		vdom = f.Client.Config.Auth.Vdom
+	}
+
+	// the responses are kept until the last caller waiting for the table
+	// returns, the callers can be nested
+	rt.lock.Lock()
+	body := w.bodies[cmdbReadKey(cmdbURLPath(cmdbObjectPath(t.path, mkeys...)), vdom)]
+	w.waiting--
+	if w.waiting == 0 {
+		delete(rt.tables, table)
+	}
+	rt.lock.Unlock()
+
+	if body == nil || d.Id() == "" {
+		return nil
+	}
+
+	var result map[string]interface{}
+	if json.Unmarshal(body, &result) != nil || cmdbResultObject(result) == nil {
+		return nil
+	}
+
+	return result
+}
+
+// cmdbResultObject returns the object of result, the response to the read of
+// a CMDB object or of a singleton table
+func cmdbResultObject(result map[string]interface{}) map[string]interface{} {
+	switch r := result["results"].(type) {
+	case map[string]interface{}:
+		return r
+	case []interface{}:
+		if len(r) != 0 {
+			o, _ := r[0].(map[string]interface{})
+			return o
+		}
+	}
+
+	return nil
+}
+
+// cmdbURLPath returns the decoded form of the API path p, as found in the
+// URL of the requests
+func cmdbURLPath(p string) string {
+	u, err := url.Parse(p)
+	if err != nil {
+		return p
+	}
+
+	return u.Path
+}
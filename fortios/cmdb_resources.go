@@ -0,0 +1,602 @@
+package fortios
+
+// cmdbResources maps the resources generated from the FortiOS CMDB tables to
+// the path of their table relative to /api/v2/cmdb, singleton tables hold a
+// single settings object instead of a list of entries
+var cmdbResources = map[string]cmdbResource{
+	"fortios_alertemail_setting":                                 {"alertemail/setting", true},
+	"fortios_antivirus_heuristic":                                {"antivirus/heuristic", true},
+	"fortios_antivirus_profile":                                  {"antivirus/profile", false},
+	"fortios_antivirus_quarantine":                               {"antivirus/quarantine", true},
+	"fortios_antivirus_settings":                                 {"antivirus/settings", true},
+	"fortios_application_custom":                                 {"application/custom", false},
+	"fortios_application_group":                                  {"application/group", false},
+	"fortios_application_list":                                   {"application/list", false},
+	"fortios_application_name":                                   {"application/name", false},
+	"fortios_application_rulesettings":                           {"application/rule-settings", false},
+	"fortios_authentication_rule":                                {"authentication/rule", false},
+	"fortios_authentication_scheme":                              {"authentication/scheme", false},
+	"fortios_authentication_setting":                             {"authentication/setting", true},
+	"fortios_automation_setting":                                 {"automation/setting", true},
+	"fortios_certificate_ca":                                     {"certificate/ca", false},
+	"fortios_certificate_crl":                                    {"certificate/crl", false},
+	"fortios_certificate_local":                                  {"certificate/local", false},
+	"fortios_certificate_remote":                                 {"certificate/remote", false},
+	"fortios_cifs_domaincontroller":                              {"cifs/domain-controller", false},
+	"fortios_cifs_profile":                                       {"cifs/profile", false},
+	"fortios_credentialstore_domaincontroller":                   {"credential-store/domain-controller", false},
+	"fortios_dlp_datatype":                                       {"dlp/data-type", false},
+	"fortios_dlp_dictionary":                                     {"dlp/dictionary", false},
+	"fortios_dlp_filepattern":                                    {"dlp/filepattern", false},
+	"fortios_dlp_fpdocsource":                                    {"dlp/fp-doc-source", false},
+	"fortios_dlp_fpsensitivity":                                  {"dlp/fp-sensitivity", false},
+	"fortios_dlp_profile":                                        {"dlp/profile", false},
+	"fortios_dlp_sensitivity":                                    {"dlp/sensitivity", false},
+	"fortios_dlp_sensor":                                         {"dlp/sensor", false},
+	"fortios_dlp_settings":                                       {"dlp/settings", true},
+	"fortios_dnsfilter_domainfilter":                             {"dnsfilter/domain-filter", false},
+	"fortios_dnsfilter_profile":                                  {"dnsfilter/profile", false},
+	"fortios_dpdk_cpus":                                          {"dpdk/cpus", true},
+	"fortios_dpdk_global":                                        {"dpdk/global", true},
+	"fortios_emailfilter_blockallowlist":                         {"emailfilter/block-allow-list", false},
+	"fortios_emailfilter_bwl":                                    {"emailfilter/bwl", false},
+	"fortios_emailfilter_bword":                                  {"emailfilter/bword", false},
+	"fortios_emailfilter_dnsbl":                                  {"emailfilter/dnsbl", false},
+	"fortios_emailfilter_fortishield":                            {"emailfilter/fortishield", true},
+	"fortios_emailfilter_iptrust":                                {"emailfilter/iptrust", false},
+	"fortios_emailfilter_mheader":                                {"emailfilter/mheader", false},
+	"fortios_emailfilter_options":                                {"emailfilter/options", true},
+	"fortios_emailfilter_profile":                                {"emailfilter/profile", false},
+	"fortios_endpointcontrol_client":                             {"endpoint-control/client", false},
+	"fortios_endpointcontrol_fctems":                             {"endpoint-control/fctems", false},
+	"fortios_endpointcontrol_forticlientems":                     {"endpoint-control/forticlient-ems", false},
+	"fortios_endpointcontrol_forticlientregistrationsync":        {"endpoint-control/forticlient-registration-sync", false},
+	"fortios_endpointcontrol_profile":                            {"endpoint-control/profile", false},
+	"fortios_endpointcontrol_registeredforticlient":              {"endpoint-control/registered-forticlient", false},
+	"fortios_endpointcontrol_settings":                           {"endpoint-control/settings", true},
+	"fortios_extendercontroller_dataplan":                        {"extender-controller/dataplan", false},
+	"fortios_extendercontroller_extender":                        {"extender-controller/extender", false},
+	"fortios_extendercontroller_extender1":                       {"extender-controller/extender", false},
+	"fortios_extendercontroller_extenderprofile":                 {"extender-controller/extender-profile", false},
+	"fortios_extensioncontroller_dataplan":                       {"extension-controller/dataplan", false},
+	"fortios_extensioncontroller_extender":                       {"extension-controller/extender", false},
+	"fortios_extensioncontroller_extenderprofile":                {"extension-controller/extender-profile", false},
+	"fortios_extensioncontroller_fortigate":                      {"extension-controller/fortigate", false},
+	"fortios_extensioncontroller_fortigateprofile":               {"extension-controller/fortigate-profile", false},
+	"fortios_filefilter_profile":                                 {"file-filter/profile", false},
+	"fortios_firewall_DoSpolicy":                                 {"firewall/DoS-policy", false},
+	"fortios_firewall_DoSpolicy6":                                {"firewall/DoS-policy6", false},
+	"fortios_firewall_accessproxy":                               {"firewall/access-proxy", false},
+	"fortios_firewall_accessproxy6":                              {"firewall/access-proxy6", false},
+	"fortios_firewall_accessproxysshclientcert":                  {"firewall/access-proxy-ssh-client-cert", false},
+	"fortios_firewall_accessproxyvirtualhost":                    {"firewall/access-proxy-virtual-host", false},
+	"fortios_firewall_address":                                   {"firewall/address", false},
+	"fortios_firewall_address6":                                  {"firewall/address6", false},
+	"fortios_firewall_address6template":                          {"firewall/address6-template", false},
+	"fortios_firewall_addrgrp":                                   {"firewall/addrgrp", false},
+	"fortios_firewall_addrgrp6":                                  {"firewall/addrgrp6", false},
+	"fortios_firewall_authportal":                                {"firewall/auth-portal", true},
+	"fortios_firewall_centralsnatmap":                            {"firewall/central-snat-map", false},
+	"fortios_firewall_city":                                      {"firewall/city", false},
+	"fortios_firewall_country":                                   {"firewall/country", false},
+	"fortios_firewall_decryptedtrafficmirror":                    {"firewall/decrypted-traffic-mirror", false},
+	"fortios_firewall_dnstranslation":                            {"firewall/dnstranslation", false},
+	"fortios_firewall_global":                                    {"firewall/global", true},
+	"fortios_firewall_identitybasedroute":                        {"firewall/identity-based-route", false},
+	"fortios_firewall_interfacepolicy":                           {"firewall/interface-policy", false},
+	"fortios_firewall_interfacepolicy6":                          {"firewall/interface-policy6", false},
+	"fortios_firewall_internetservice":                           {"firewall/internet-service", false},
+	"fortios_firewall_internetserviceaddition":                   {"firewall/internet-service-addition", false},
+	"fortios_firewall_internetserviceappend":                     {"firewall/internet-service-append", true},
+	"fortios_firewall_internetservicebotnet":                     {"firewall/internet-service-botnet", false},
+	"fortios_firewall_internetservicecustom":                     {"firewall/internet-service-custom", false},
+	"fortios_firewall_internetservicecustomgroup":                {"firewall/internet-service-custom-group", false},
+	"fortios_firewall_internetservicedefinition":                 {"firewall/internet-service-definition", false},
+	"fortios_firewall_internetserviceextension":                  {"firewall/internet-service-extension", false},
+	"fortios_firewall_internetservicegroup":                      {"firewall/internet-service-group", false},
+	"fortios_firewall_internetserviceipblreason":                 {"firewall/internet-service-ipbl-reason", false},
+	"fortios_firewall_internetserviceipblvendor":                 {"firewall/internet-service-ipbl-vendor", false},
+	"fortios_firewall_internetservicelist":                       {"firewall/internet-service-list", false},
+	"fortios_firewall_internetservicename":                       {"firewall/internet-service-name", false},
+	"fortios_firewall_internetserviceowner":                      {"firewall/internet-service-owner", false},
+	"fortios_firewall_internetservicereputation":                 {"firewall/internet-service-reputation", false},
+	"fortios_firewall_ippool":                                    {"firewall/ippool", false},
+	"fortios_firewall_ippool6":                                   {"firewall/ippool6", false},
+	"fortios_firewall_iptranslation":                             {"firewall/ip-translation", false},
+	"fortios_firewall_ipv6ehfilter":                              {"firewall/ipv6-eh-filter", true},
+	"fortios_firewall_ldbmonitor":                                {"firewall/ldb-monitor", false},
+	"fortios_firewall_localinpolicy":                             {"firewall/local-in-policy", false},
+	"fortios_firewall_localinpolicy6":                            {"firewall/local-in-policy6", false},
+	"fortios_firewall_multicastaddress":                          {"firewall/multicast-address", false},
+	"fortios_firewall_multicastaddress6":                         {"firewall/multicast-address6", false},
+	"fortios_firewall_multicastpolicy":                           {"firewall/multicast-policy", false},
+	"fortios_firewall_multicastpolicy6":                          {"firewall/multicast-policy6", false},
+	"fortios_firewall_networkservicedynamic":                     {"firewall/network-service-dynamic", false},
+	"fortios_firewall_policy":                                    {"firewall/policy", false},
+	"fortios_firewall_policy46":                                  {"firewall/policy46", false},
+	"fortios_firewall_policy6":                                   {"firewall/policy6", false},
+	"fortios_firewall_policy64":                                  {"firewall/policy64", false},
+	"fortios_firewall_profilegroup":                              {"firewall/profile-group", false},
+	"fortios_firewall_profileprotocoloptions":                    {"firewall/profile-protocol-options", false},
+	"fortios_firewall_proxyaddress":                              {"firewall/proxy-address", false},
+	"fortios_firewall_proxyaddrgrp":                              {"firewall/proxy-addrgrp", false},
+	"fortios_firewall_proxypolicy":                               {"firewall/proxy-policy", false},
+	"fortios_firewall_region":                                    {"firewall/region", false},
+	"fortios_firewall_securitypolicy":                            {"firewall/security-policy", false},
+	"fortios_firewall_shapingpolicy":                             {"firewall/shaping-policy", false},
+	"fortios_firewall_shapingprofile":                            {"firewall/shaping-profile", false},
+	"fortios_firewall_sniffer":                                   {"firewall/sniffer", false},
+	"fortios_firewall_sslserver":                                 {"firewall/ssl-server", false},
+	"fortios_firewall_sslsshprofile":                             {"firewall/ssl-ssh-profile", false},
+	"fortios_firewall_trafficclass":                              {"firewall/traffic-class", false},
+	"fortios_firewall_ttlpolicy":                                 {"firewall/ttl-policy", false},
+	"fortios_firewall_vendormac":                                 {"firewall/vendor-mac", false},
+	"fortios_firewall_vip":                                       {"firewall/vip", false},
+	"fortios_firewall_vip46":                                     {"firewall/vip46", false},
+	"fortios_firewall_vip6":                                      {"firewall/vip6", false},
+	"fortios_firewall_vip64":                                     {"firewall/vip64", false},
+	"fortios_firewall_vipgrp":                                    {"firewall/vipgrp", false},
+	"fortios_firewall_vipgrp46":                                  {"firewall/vipgrp46", false},
+	"fortios_firewall_vipgrp6":                                   {"firewall/vipgrp6", false},
+	"fortios_firewall_vipgrp64":                                  {"firewall/vipgrp64", false},
+	"fortios_firewallconsolidated_policy":                        {"firewall.consolidated/policy", false},
+	"fortios_firewallipmacbinding_setting":                       {"firewall.ipmacbinding/setting", true},
+	"fortios_firewallipmacbinding_table":                         {"firewall.ipmacbinding/table", false},
+	"fortios_firewallschedule_group":                             {"firewall.schedule/group", false},
+	"fortios_firewallschedule_onetime":                           {"firewall.schedule/onetime", false},
+	"fortios_firewallschedule_recurring":                         {"firewall.schedule/recurring", false},
+	"fortios_firewallservice_category":                           {"firewall.service/category", false},
+	"fortios_firewallservice_custom":                             {"firewall.service/custom", false},
+	"fortios_firewallservice_group":                              {"firewall.service/group", false},
+	"fortios_firewallshaper_peripshaper":                         {"firewall.shaper/per-ip-shaper", false},
+	"fortios_firewallshaper_trafficshaper":                       {"firewall.shaper/traffic-shaper", false},
+	"fortios_firewallssh_hostkey":                                {"firewall.ssh/host-key", false},
+	"fortios_firewallssh_localca":                                {"firewall.ssh/local-ca", false},
+	"fortios_firewallssh_localkey":                               {"firewall.ssh/local-key", false},
+	"fortios_firewallssh_setting":                                {"firewall.ssh/setting", true},
+	"fortios_firewallssl_setting":                                {"firewall.ssl/setting", true},
+	"fortios_firewallwildcardfqdn_custom":                        {"firewall.wildcard-fqdn/custom", false},
+	"fortios_firewallwildcardfqdn_group":                         {"firewall.wildcard-fqdn/group", false},
+	"fortios_ftpproxy_explicit":                                  {"ftp-proxy/explicit", true},
+	"fortios_icap_profile":                                       {"icap/profile", false},
+	"fortios_icap_server":                                        {"icap/server", false},
+	"fortios_icap_servergroup":                                   {"icap/server-group", false},
+	"fortios_ips_custom":                                         {"ips/custom", false},
+	"fortios_ips_decoder":                                        {"ips/decoder", false},
+	"fortios_ips_global":                                         {"ips/global", true},
+	"fortios_ips_rule":                                           {"ips/rule", false},
+	"fortios_ips_rulesettings":                                   {"ips/rule-settings", false},
+	"fortios_ips_sensor":                                         {"ips/sensor", false},
+	"fortios_ips_settings":                                       {"ips/settings", true},
+	"fortios_ips_viewmap":                                        {"ips/view-map", false},
+	"fortios_log_customfield":                                    {"log/custom-field", false},
+	"fortios_log_eventfilter":                                    {"log/eventfilter", true},
+	"fortios_log_guidisplay":                                     {"log/gui-display", true},
+	"fortios_log_setting":                                        {"log/setting", true},
+	"fortios_log_threatweight":                                   {"log/threat-weight", true},
+	"fortios_logdisk_filter":                                     {"log.disk/filter", true},
+	"fortios_logdisk_setting":                                    {"log.disk/setting", true},
+	"fortios_logfortianalyzer2_filter":                           {"log.fortianalyzer2/filter", true},
+	"fortios_logfortianalyzer2_overridefilter":                   {"log.fortianalyzer2/override-filter", true},
+	"fortios_logfortianalyzer2_overridesetting":                  {"log.fortianalyzer2/override-setting", true},
+	"fortios_logfortianalyzer2_setting":                          {"log.fortianalyzer2/setting", true},
+	"fortios_logfortianalyzer3_filter":                           {"log.fortianalyzer3/filter", true},
+	"fortios_logfortianalyzer3_overridefilter":                   {"log.fortianalyzer3/override-filter", true},
+	"fortios_logfortianalyzer3_overridesetting":                  {"log.fortianalyzer3/override-setting", true},
+	"fortios_logfortianalyzer3_setting":                          {"log.fortianalyzer3/setting", true},
+	"fortios_logfortianalyzer_filter":                            {"log.fortianalyzer/filter", true},
+	"fortios_logfortianalyzer_overridefilter":                    {"log.fortianalyzer/override-filter", true},
+	"fortios_logfortianalyzer_overridesetting":                   {"log.fortianalyzer/override-setting", true},
+	"fortios_logfortianalyzer_setting":                           {"log.fortianalyzer/setting", true},
+	"fortios_logfortianalyzercloud_filter":                       {"log.fortianalyzer-cloud/filter", true},
+	"fortios_logfortianalyzercloud_overridefilter":               {"log.fortianalyzer-cloud/override-filter", true},
+	"fortios_logfortianalyzercloud_overridesetting":              {"log.fortianalyzer-cloud/override-setting", true},
+	"fortios_logfortianalyzercloud_setting":                      {"log.fortianalyzer-cloud/setting", true},
+	"fortios_logfortiguard_filter":                               {"log.fortiguard/filter", true},
+	"fortios_logfortiguard_overridefilter":                       {"log.fortiguard/override-filter", true},
+	"fortios_logfortiguard_overridesetting":                      {"log.fortiguard/override-setting", true},
+	"fortios_logfortiguard_setting":                              {"log.fortiguard/setting", true},
+	"fortios_logmemory_filter":                                   {"log.memory/filter", true},
+	"fortios_logmemory_globalsetting":                            {"log.memory/global-setting", true},
+	"fortios_logmemory_setting":                                  {"log.memory/setting", true},
+	"fortios_lognulldevice_filter":                               {"log.null-device/filter", true},
+	"fortios_lognulldevice_setting":                              {"log.null-device/setting", true},
+	"fortios_logsyslogd2_filter":                                 {"log.syslogd2/filter", true},
+	"fortios_logsyslogd2_overridefilter":                         {"log.syslogd2/override-filter", true},
+	"fortios_logsyslogd2_overridesetting":                        {"log.syslogd2/override-setting", true},
+	"fortios_logsyslogd2_setting":                                {"log.syslogd2/setting", true},
+	"fortios_logsyslogd3_filter":                                 {"log.syslogd3/filter", true},
+	"fortios_logsyslogd3_overridefilter":                         {"log.syslogd3/override-filter", true},
+	"fortios_logsyslogd3_overridesetting":                        {"log.syslogd3/override-setting", true},
+	"fortios_logsyslogd3_setting":                                {"log.syslogd3/setting", true},
+	"fortios_logsyslogd4_filter":                                 {"log.syslogd4/filter", true},
+	"fortios_logsyslogd4_overridefilter":                         {"log.syslogd4/override-filter", true},
+	"fortios_logsyslogd4_overridesetting":                        {"log.syslogd4/override-setting", true},
+	"fortios_logsyslogd4_setting":                                {"log.syslogd4/setting", true},
+	"fortios_logsyslogd_filter":                                  {"log.syslogd/filter", true},
+	"fortios_logsyslogd_overridefilter":                          {"log.syslogd/override-filter", true},
+	"fortios_logsyslogd_overridesetting":                         {"log.syslogd/override-setting", true},
+	"fortios_logsyslogd_setting":                                 {"log.syslogd/setting", true},
+	"fortios_logtacacsaccounting2_filter":                        {"log.tacacs+accounting2/filter", true},
+	"fortios_logtacacsaccounting2_setting":                       {"log.tacacs+accounting2/setting", true},
+	"fortios_logtacacsaccounting3_filter":                        {"log.tacacs+accounting3/filter", true},
+	"fortios_logtacacsaccounting3_setting":                       {"log.tacacs+accounting3/setting", true},
+	"fortios_logtacacsaccounting_filter":                         {"log.tacacs+accounting/filter", true},
+	"fortios_logtacacsaccounting_setting":                        {"log.tacacs+accounting/setting", true},
+	"fortios_logwebtrends_filter":                                {"log.webtrends/filter", true},
+	"fortios_logwebtrends_setting":                               {"log.webtrends/setting", true},
+	"fortios_nsxt_servicechain":                                  {"nsxt/service-chain", false},
+	"fortios_nsxt_setting":                                       {"nsxt/setting", true},
+	"fortios_report_chart":                                       {"report/chart", false},
+	"fortios_report_dataset":                                     {"report/dataset", false},
+	"fortios_report_layout":                                      {"report/layout", false},
+	"fortios_report_setting":                                     {"report/setting", true},
+	"fortios_report_style":                                       {"report/style", false},
+	"fortios_report_theme":                                       {"report/theme", false},
+	"fortios_router_accesslist":                                  {"router/access-list", false},
+	"fortios_router_accesslist6":                                 {"router/access-list6", false},
+	"fortios_router_aspathlist":                                  {"router/aspath-list", false},
+	"fortios_router_authpath":                                    {"router/auth-path", false},
+	"fortios_router_bfd":                                         {"router/bfd", true},
+	"fortios_router_bfd6":                                        {"router/bfd6", true},
+	"fortios_router_bgp":                                         {"router/bgp", true},
+	"fortios_router_communitylist":                               {"router/community-list", false},
+	"fortios_router_isis":                                        {"router/isis", true},
+	"fortios_router_keychain":                                    {"router/key-chain", false},
+	"fortios_router_multicast":                                   {"router/multicast", true},
+	"fortios_router_multicast6":                                  {"router/multicast6", true},
+	"fortios_router_multicastflow":                               {"router/multicast-flow", false},
+	"fortios_router_ospf":                                        {"router/ospf", true},
+	"fortios_router_ospf6":                                       {"router/ospf6", true},
+	"fortios_router_policy":                                      {"router/policy", false},
+	"fortios_router_policy6":                                     {"router/policy6", false},
+	"fortios_router_prefixlist":                                  {"router/prefix-list", false},
+	"fortios_router_prefixlist6":                                 {"router/prefix-list6", false},
+	"fortios_router_rip":                                         {"router/rip", true},
+	"fortios_router_ripng":                                       {"router/ripng", true},
+	"fortios_router_routemap":                                    {"router/route-map", false},
+	"fortios_router_setting":                                     {"router/setting", true},
+	"fortios_router_static":                                      {"router/static", false},
+	"fortios_router_static6":                                     {"router/static6", false},
+	"fortios_routerbgp_neighbor":                                 {"router/bgp/neighbor", false},
+	"fortios_routerbgp_network":                                  {"router/bgp/network", false},
+	"fortios_routerbgp_network6":                                 {"router/bgp/network6", false},
+	"fortios_routerospf6_ospf6interface":                         {"router/ospf6/ospf6-interface", false},
+	"fortios_routerospf_neighbor":                                {"router/ospf/neighbor", false},
+	"fortios_routerospf_network":                                 {"router/ospf/network", false},
+	"fortios_routerospf_ospfinterface":                           {"router/ospf/ospf-interface", false},
+	"fortios_sctpfilter_profile":                                 {"sctp-filter/profile", false},
+	"fortios_spamfilter_bwl":                                     {"spamfilter/bwl", false},
+	"fortios_spamfilter_bword":                                   {"spamfilter/bword", false},
+	"fortios_spamfilter_dnsbl":                                   {"spamfilter/dnsbl", false},
+	"fortios_spamfilter_fortishield":                             {"spamfilter/fortishield", true},
+	"fortios_spamfilter_iptrust":                                 {"spamfilter/iptrust", false},
+	"fortios_spamfilter_mheader":                                 {"spamfilter/mheader", false},
+	"fortios_spamfilter_options":                                 {"spamfilter/options", true},
+	"fortios_spamfilter_profile":                                 {"spamfilter/profile", false},
+	"fortios_sshfilter_profile":                                  {"ssh-filter/profile", false},
+	"fortios_switchcontroller_8021Xsettings":                     {"switch-controller/802-1X-settings", true},
+	"fortios_switchcontroller_customcommand":                     {"switch-controller/custom-command", false},
+	"fortios_switchcontroller_dynamicportpolicy":                 {"switch-controller/dynamic-port-policy", false},
+	"fortios_switchcontroller_flowtracking":                      {"switch-controller/flow-tracking", true},
+	"fortios_switchcontroller_fortilinksettings":                 {"switch-controller/fortilink-settings", false},
+	"fortios_switchcontroller_global":                            {"switch-controller/global", true},
+	"fortios_switchcontroller_igmpsnooping":                      {"switch-controller/igmp-snooping", true},
+	"fortios_switchcontroller_lldpprofile":                       {"switch-controller/lldp-profile", false},
+	"fortios_switchcontroller_lldpsettings":                      {"switch-controller/lldp-settings", true},
+	"fortios_switchcontroller_location":                          {"switch-controller/location", false},
+	"fortios_switchcontroller_macsyncsettings":                   {"switch-controller/mac-sync-settings", true},
+	"fortios_switchcontroller_managedswitch":                     {"switch-controller/managed-switch", false},
+	"fortios_switchcontroller_nacdevice":                         {"switch-controller/nac-device", false},
+	"fortios_switchcontroller_nacsettings":                       {"switch-controller/nac-settings", false},
+	"fortios_switchcontroller_networkmonitorsettings":            {"switch-controller/network-monitor-settings", true},
+	"fortios_switchcontroller_portpolicy":                        {"switch-controller/port-policy", false},
+	"fortios_switchcontroller_quarantine":                        {"switch-controller/quarantine", true},
+	"fortios_switchcontroller_remotelog":                         {"switch-controller/remote-log", false},
+	"fortios_switchcontroller_sflow":                             {"switch-controller/sflow", true},
+	"fortios_switchcontroller_snmpcommunity":                     {"switch-controller/snmp-community", false},
+	"fortios_switchcontroller_snmpsysinfo":                       {"switch-controller/snmp-sysinfo", true},
+	"fortios_switchcontroller_snmptrapthreshold":                 {"switch-controller/snmp-trap-threshold", true},
+	"fortios_switchcontroller_snmpuser":                          {"switch-controller/snmp-user", false},
+	"fortios_switchcontroller_stormcontrol":                      {"switch-controller/storm-control", true},
+	"fortios_switchcontroller_stormcontrolpolicy":                {"switch-controller/storm-control-policy", false},
+	"fortios_switchcontroller_stpinstance":                       {"switch-controller/stp-instance", false},
+	"fortios_switchcontroller_stpsettings":                       {"switch-controller/stp-settings", true},
+	"fortios_switchcontroller_switchgroup":                       {"switch-controller/switch-group", false},
+	"fortios_switchcontroller_switchinterfacetag":                {"switch-controller/switch-interface-tag", false},
+	"fortios_switchcontroller_switchlog":                         {"switch-controller/switch-log", true},
+	"fortios_switchcontroller_switchprofile":                     {"switch-controller/switch-profile", false},
+	"fortios_switchcontroller_system":                            {"switch-controller/system", true},
+	"fortios_switchcontroller_trafficpolicy":                     {"switch-controller/traffic-policy", false},
+	"fortios_switchcontroller_trafficsniffer":                    {"switch-controller/traffic-sniffer", true},
+	"fortios_switchcontroller_virtualportpool":                   {"switch-controller/virtual-port-pool", false},
+	"fortios_switchcontroller_vlan":                              {"switch-controller/vlan", false},
+	"fortios_switchcontroller_vlanpolicy":                        {"switch-controller/vlan-policy", false},
+	"fortios_switchcontrollerautoconfig_custom":                  {"switch-controller.auto-config/custom", false},
+	"fortios_switchcontrollerautoconfig_default":                 {"switch-controller.auto-config/default", true},
+	"fortios_switchcontrollerautoconfig_policy":                  {"switch-controller.auto-config/policy", false},
+	"fortios_switchcontrollerinitialconfig_template":             {"switch-controller.initial-config/template", false},
+	"fortios_switchcontrollerinitialconfig_vlans":                {"switch-controller.initial-config/vlans", true},
+	"fortios_switchcontrollerptp_policy":                         {"switch-controller.ptp/policy", false},
+	"fortios_switchcontrollerptp_settings":                       {"switch-controller.ptp/settings", true},
+	"fortios_switchcontrollerqos_dot1pmap":                       {"switch-controller.qos/dot1p-map", false},
+	"fortios_switchcontrollerqos_ipdscpmap":                      {"switch-controller.qos/ip-dscp-map", false},
+	"fortios_switchcontrollerqos_qospolicy":                      {"switch-controller.qos/qos-policy", false},
+	"fortios_switchcontrollerqos_queuepolicy":                    {"switch-controller.qos/queue-policy", false},
+	"fortios_switchcontrollersecuritypolicy_8021X":               {"switch-controller.security-policy/802-1X", false},
+	"fortios_switchcontrollersecuritypolicy_captiveportal":       {"switch-controller.security-policy/captive-portal", false},
+	"fortios_switchcontrollersecuritypolicy_localaccess":         {"switch-controller.security-policy/local-access", false},
+	"fortios_system3gmodem_custom":                               {"system.3g-modem/custom", false},
+	"fortios_system_accprofile":                                  {"system/accprofile", false},
+	"fortios_system_acme":                                        {"system/acme", true},
+	"fortios_system_admin":                                       {"system/admin", false},
+	"fortios_system_affinityinterrupt":                           {"system/affinity-interrupt", false},
+	"fortios_system_affinitypacketredistribution":                {"system/affinity-packet-redistribution", false},
+	"fortios_system_alarm":                                       {"system/alarm", true},
+	"fortios_system_alias":                                       {"system/alias", false},
+	"fortios_system_apiuser":                                     {"system/api-user", false},
+	"fortios_system_arptable":                                    {"system/arp-table", false},
+	"fortios_system_autoinstall":                                 {"system/auto-install", true},
+	"fortios_system_automationaction":                            {"system/automation-action", false},
+	"fortios_system_automationdestination":                       {"system/automation-destination", false},
+	"fortios_system_automationstitch":                            {"system/automation-stitch", false},
+	"fortios_system_automationtrigger":                           {"system/automation-trigger", false},
+	"fortios_system_autoscript":                                  {"system/auto-script", false},
+	"fortios_system_centralmanagement":                           {"system/central-management", true},
+	"fortios_system_clustersync":                                 {"system/cluster-sync", false},
+	"fortios_system_console":                                     {"system/console", true},
+	"fortios_system_csf":                                         {"system/csf", true},
+	"fortios_system_customlanguage":                              {"system/custom-language", false},
+	"fortios_system_ddns":                                        {"system/ddns", false},
+	"fortios_system_dedicatedmgmt":                               {"system/dedicated-mgmt", true},
+	"fortios_system_dns":                                         {"system/dns", true},
+	"fortios_system_dns64":                                       {"system/dns64", true},
+	"fortios_system_dnsdatabase":                                 {"system/dns-database", false},
+	"fortios_system_dnsserver":                                   {"system/dns-server", false},
+	"fortios_system_dscpbasedpriority":                           {"system/dscp-based-priority", false},
+	"fortios_system_emailserver":                                 {"system/email-server", true},
+	"fortios_system_externalresource":                            {"system/external-resource", false},
+	"fortios_system_federatedupgrade":                            {"system/federated-upgrade", true},
+	"fortios_system_fipscc":                                      {"system/fips-cc", true},
+	"fortios_system_fm":                                          {"system/fm", true},
+	"fortios_system_fortiai":                                     {"system/fortiai", true},
+	"fortios_system_fortiguard":                                  {"system/fortiguard", true},
+	"fortios_system_fortimanager":                                {"system/fortimanager", true},
+	"fortios_system_fortindr":                                    {"system/fortindr", true},
+	"fortios_system_fortisandbox":                                {"system/fortisandbox", true},
+	"fortios_system_fssopolling":                                 {"system/fsso-polling", true},
+	"fortios_system_ftmpush":                                     {"system/ftm-push", true},
+	"fortios_system_geneve":                                      {"system/geneve", false},
+	"fortios_system_geoipcountry":                                {"system/geoip-country", false},
+	"fortios_system_geoipoverride":                               {"system/geoip-override", false},
+	"fortios_system_global":                                      {"system/global", true},
+	"fortios_system_gretunnel":                                   {"system/gre-tunnel", false},
+	"fortios_system_ha":                                          {"system/ha", true},
+	"fortios_system_hamonitor":                                   {"system/ha-monitor", true},
+	"fortios_system_ike":                                         {"system/ike", true},
+	"fortios_system_interface":                                   {"system/interface", false},
+	"fortios_system_ipam":                                        {"system/ipam", true},
+	"fortios_system_ipiptunnel":                                  {"system/ipip-tunnel", false},
+	"fortios_system_ips":                                         {"system/ips", true},
+	"fortios_system_ipsecaggregate":                              {"system/ipsec-aggregate", false},
+	"fortios_system_ipsurlfilterdns":                             {"system/ips-urlfilter-dns", false},
+	"fortios_system_ipsurlfilterdns6":                            {"system/ips-urlfilter-dns6", false},
+	"fortios_system_ipv6neighborcache":                           {"system/ipv6-neighbor-cache", false},
+	"fortios_system_ipv6tunnel":                                  {"system/ipv6-tunnel", false},
+	"fortios_system_linkmonitor":                                 {"system/link-monitor", false},
+	"fortios_system_ltemodem":                                    {"system/lte-modem", true},
+	"fortios_system_macaddresstable":                             {"system/mac-address-table", false},
+	"fortios_system_managementtunnel":                            {"system/management-tunnel", true},
+	"fortios_system_mobiletunnel":                                {"system/mobile-tunnel", false},
+	"fortios_system_modem":                                       {"system/modem", true},
+	"fortios_system_nat64":                                       {"system/nat64", true},
+	"fortios_system_ndproxy":                                     {"system/nd-proxy", true},
+	"fortios_system_netflow":                                     {"system/netflow", true},
+	"fortios_system_networkvisibility":                           {"system/network-visibility", true},
+	"fortios_system_npu":                                         {"system/npu", true},
+	"fortios_system_ntp":                                         {"system/ntp", true},
+	"fortios_system_objecttagging":                               {"system/object-tagging", false},
+	"fortios_system_passwordpolicy":                              {"system/password-policy", true},
+	"fortios_system_passwordpolicyguestadmin":                    {"system/password-policy-guest-admin", true},
+	"fortios_system_physicalswitch":                              {"system/physical-switch", false},
+	"fortios_system_pppoeinterface":                              {"system/pppoe-interface", false},
+	"fortios_system_proberesponse":                               {"system/probe-response", true},
+	"fortios_system_proxyarp":                                    {"system/proxy-arp", false},
+	"fortios_system_ptp":                                         {"system/ptp", true},
+	"fortios_system_replacemsggroup":                             {"system/replacemsg-group", false},
+	"fortios_system_replacemsgimage":                             {"system/replacemsg-image", false},
+	"fortios_system_resourcelimits":                              {"system/resource-limits", true},
+	"fortios_system_saml":                                        {"system/saml", true},
+	"fortios_system_sdnconnector":                                {"system/sdn-connector", false},
+	"fortios_system_sdwan":                                       {"system/sdwan", true},
+	"fortios_system_sessionhelper":                               {"system/session-helper", false},
+	"fortios_system_sessionttl":                                  {"system/session-ttl", true},
+	"fortios_system_settings":                                    {"system/settings", true},
+	"fortios_system_sflow":                                       {"system/sflow", true},
+	"fortios_system_sittunnel":                                   {"system/sit-tunnel", false},
+	"fortios_system_smsserver":                                   {"system/sms-server", false},
+	"fortios_system_speedtestschedule":                           {"system/speed-test-schedule", false},
+	"fortios_system_speedtestserver":                             {"system/speed-test-server", false},
+	"fortios_system_ssoadmin":                                    {"system/sso-admin", false},
+	"fortios_system_ssoforticloudadmin":                          {"system/sso-forticloud-admin", false},
+	"fortios_system_standalonecluster":                           {"system/standalone-cluster", true},
+	"fortios_system_storage":                                     {"system/storage", false},
+	"fortios_system_stp":                                         {"system/stp", true},
+	"fortios_system_switchinterface":                             {"system/switch-interface", false},
+	"fortios_system_tosbasedpriority":                            {"system/tos-based-priority", false},
+	"fortios_system_vdom":                                        {"system/vdom", false},
+	"fortios_system_vdomdns":                                     {"system/vdom-dns", true},
+	"fortios_system_vdomexception":                               {"system/vdom-exception", false},
+	"fortios_system_vdomlink":                                    {"system/vdom-link", false},
+	"fortios_system_vdomnetflow":                                 {"system/vdom-netflow", true},
+	"fortios_system_vdomproperty":                                {"system/vdom-property", false},
+	"fortios_system_vdomradiusserver":                            {"system/vdom-radius-server", false},
+	"fortios_system_vdomsflow":                                   {"system/vdom-sflow", true},
+	"fortios_system_virtualswitch":                               {"system/virtual-switch", false},
+	"fortios_system_virtualwanlink":                              {"system/virtual-wan-link", true},
+	"fortios_system_virtualwirepair":                             {"system/virtual-wire-pair", false},
+	"fortios_system_vnetunnel":                                   {"system/vne-tunnel", true},
+	"fortios_system_vxlan":                                       {"system/vxlan", false},
+	"fortios_system_wccp":                                        {"system/wccp", false},
+	"fortios_system_zone":                                        {"system/zone", false},
+	"fortios_systemautoupdate_pushupdate":                        {"system.autoupdate/push-update", true},
+	"fortios_systemautoupdate_schedule":                          {"system.autoupdate/schedule", true},
+	"fortios_systemautoupdate_tunneling":                         {"system.autoupdate/tunneling", true},
+	"fortios_systemdhcp6_server":                                 {"system.dhcp6/server", false},
+	"fortios_systemdhcp_server":                                  {"system.dhcp/server", false},
+	"fortios_systemlldp_networkpolicy":                           {"system.lldp/network-policy", false},
+	"fortios_systemreplacemsg_admin":                             {"system.replacemsg/admin", false},
+	"fortios_systemreplacemsg_alertmail":                         {"system.replacemsg/alertmail", false},
+	"fortios_systemreplacemsg_auth":                              {"system.replacemsg/auth", false},
+	"fortios_systemreplacemsg_automation":                        {"system.replacemsg/automation", false},
+	"fortios_systemreplacemsg_devicedetectionportal":             {"system.replacemsg/device-detection-portal", false},
+	"fortios_systemreplacemsg_ec":                                {"system.replacemsg/ec", false},
+	"fortios_systemreplacemsg_fortiguardwf":                      {"system.replacemsg/fortiguard-wf", false},
+	"fortios_systemreplacemsg_ftp":                               {"system.replacemsg/ftp", false},
+	"fortios_systemreplacemsg_http":                              {"system.replacemsg/http", false},
+	"fortios_systemreplacemsg_icap":                              {"system.replacemsg/icap", false},
+	"fortios_systemreplacemsg_mail":                              {"system.replacemsg/mail", false},
+	"fortios_systemreplacemsg_nacquar":                           {"system.replacemsg/nac-quar", false},
+	"fortios_systemreplacemsg_nntp":                              {"system.replacemsg/nntp", false},
+	"fortios_systemreplacemsg_spam":                              {"system.replacemsg/spam", false},
+	"fortios_systemreplacemsg_sslvpn":                            {"system.replacemsg/sslvpn", false},
+	"fortios_systemreplacemsg_trafficquota":                      {"system.replacemsg/traffic-quota", false},
+	"fortios_systemreplacemsg_utm":                               {"system.replacemsg/utm", false},
+	"fortios_systemreplacemsg_webproxy":                          {"system.replacemsg/webproxy", false},
+	"fortios_systemsnmp_community":                               {"system.snmp/community", false},
+	"fortios_systemsnmp_mibview":                                 {"system.snmp/mib-view", false},
+	"fortios_systemsnmp_sysinfo":                                 {"system.snmp/sysinfo", true},
+	"fortios_systemsnmp_user":                                    {"system.snmp/user", false},
+	"fortios_user_adgrp":                                         {"user/adgrp", false},
+	"fortios_user_certificate":                                   {"user/certificate", false},
+	"fortios_user_device":                                        {"user/device", false},
+	"fortios_user_deviceaccesslist":                              {"user/device-access-list", false},
+	"fortios_user_devicecategory":                                {"user/device-category", false},
+	"fortios_user_devicegroup":                                   {"user/device-group", false},
+	"fortios_user_domaincontroller":                              {"user/domain-controller", false},
+	"fortios_user_exchange":                                      {"user/exchange", false},
+	"fortios_user_fortitoken":                                    {"user/fortitoken", false},
+	"fortios_user_fsso":                                          {"user/fsso", false},
+	"fortios_user_fssopolling":                                   {"user/fsso-polling", false},
+	"fortios_user_group":                                         {"user/group", false},
+	"fortios_user_krbkeytab":                                     {"user/krb-keytab", false},
+	"fortios_user_ldap":                                          {"user/ldap", false},
+	"fortios_user_local":                                         {"user/local", false},
+	"fortios_user_nacpolicy":                                     {"user/nac-policy", false},
+	"fortios_user_passwordpolicy":                                {"user/password-policy", false},
+	"fortios_user_peer":                                          {"user/peer", false},
+	"fortios_user_peergrp":                                       {"user/peergrp", false},
+	"fortios_user_pop3":                                          {"user/pop3", false},
+	"fortios_user_quarantine":                                    {"user/quarantine", true},
+	"fortios_user_radius":                                        {"user/radius", false},
+	"fortios_user_saml":                                          {"user/saml", false},
+	"fortios_user_securityexemptlist":                            {"user/security-exempt-list", false},
+	"fortios_user_setting":                                       {"user/setting", true},
+	"fortios_user_tacacs":                                        {"user/tacacs+", false},
+	"fortios_videofilter_profile":                                {"videofilter/profile", false},
+	"fortios_videofilter_youtubechannelfilter":                   {"videofilter/youtube-channel-filter", false},
+	"fortios_videofilter_youtubekey":                             {"videofilter/youtube-key", false},
+	"fortios_voip_profile":                                       {"voip/profile", false},
+	"fortios_vpn_l2tp":                                           {"vpn/l2tp", true},
+	"fortios_vpn_ocvpn":                                          {"vpn/ocvpn", true},
+	"fortios_vpn_pptp":                                           {"vpn/pptp", true},
+	"fortios_vpncertificate_ca":                                  {"vpn.certificate/ca", false},
+	"fortios_vpncertificate_crl":                                 {"vpn.certificate/crl", false},
+	"fortios_vpncertificate_local":                               {"vpn.certificate/local", false},
+	"fortios_vpncertificate_ocspserver":                          {"vpn.certificate/ocsp-server", false},
+	"fortios_vpncertificate_remote":                              {"vpn.certificate/remote", false},
+	"fortios_vpncertificate_setting":                             {"vpn.certificate/setting", true},
+	"fortios_vpnipsec_concentrator":                              {"vpn.ipsec/concentrator", false},
+	"fortios_vpnipsec_fec":                                       {"vpn.ipsec/fec", false},
+	"fortios_vpnipsec_forticlient":                               {"vpn.ipsec/forticlient", false},
+	"fortios_vpnipsec_manualkey":                                 {"vpn.ipsec/manualkey", false},
+	"fortios_vpnipsec_manualkeyinterface":                        {"vpn.ipsec/manualkey-interface", false},
+	"fortios_vpnipsec_phase1":                                    {"vpn.ipsec/phase1", false},
+	"fortios_vpnipsec_phase1interface":                           {"vpn.ipsec/phase1-interface", false},
+	"fortios_vpnipsec_phase2":                                    {"vpn.ipsec/phase2", false},
+	"fortios_vpnipsec_phase2interface":                           {"vpn.ipsec/phase2-interface", false},
+	"fortios_vpnssl_client":                                      {"vpn.ssl/client", false},
+	"fortios_vpnssl_settings":                                    {"vpn.ssl/settings", true},
+	"fortios_vpnsslweb_hostchecksoftware":                        {"vpn.ssl.web/host-check-software", false},
+	"fortios_vpnsslweb_portal":                                   {"vpn.ssl.web/portal", false},
+	"fortios_vpnsslweb_realm":                                    {"vpn.ssl.web/realm", false},
+	"fortios_vpnsslweb_userbookmark":                             {"vpn.ssl.web/user-bookmark", false},
+	"fortios_vpnsslweb_usergroupbookmark":                        {"vpn.ssl.web/user-group-bookmark", false},
+	"fortios_waf_mainclass":                                      {"waf/main-class", false},
+	"fortios_waf_profile":                                        {"waf/profile", false},
+	"fortios_waf_signature":                                      {"waf/signature", false},
+	"fortios_waf_subclass":                                       {"waf/sub-class", false},
+	"fortios_wanopt_authgroup":                                   {"wanopt/auth-group", false},
+	"fortios_wanopt_cacheservice":                                {"wanopt/cache-service", true},
+	"fortios_wanopt_contentdeliverynetworkrule":                  {"wanopt/content-delivery-network-rule", false},
+	"fortios_wanopt_peer":                                        {"wanopt/peer", false},
+	"fortios_wanopt_profile":                                     {"wanopt/profile", false},
+	"fortios_wanopt_remotestorage":                               {"wanopt/remote-storage", true},
+	"fortios_wanopt_settings":                                    {"wanopt/settings", true},
+	"fortios_wanopt_webcache":                                    {"wanopt/webcache", true},
+	"fortios_webfilter_content":                                  {"webfilter/content", false},
+	"fortios_webfilter_contentheader":                            {"webfilter/content-header", false},
+	"fortios_webfilter_fortiguard":                               {"webfilter/fortiguard", true},
+	"fortios_webfilter_ftgdlocalcat":                             {"webfilter/ftgd-local-cat", false},
+	"fortios_webfilter_ftgdlocalrating":                          {"webfilter/ftgd-local-rating", false},
+	"fortios_webfilter_ipsurlfiltercachesetting":                 {"webfilter/ips-urlfilter-cache-setting", true},
+	"fortios_webfilter_ipsurlfiltersetting":                      {"webfilter/ips-urlfilter-setting", true},
+	"fortios_webfilter_ipsurlfiltersetting6":                     {"webfilter/ips-urlfilter-setting6", true},
+	"fortios_webfilter_override":                                 {"webfilter/override", false},
+	"fortios_webfilter_profile":                                  {"webfilter/profile", false},
+	"fortios_webfilter_searchengine":                             {"webfilter/search-engine", false},
+	"fortios_webfilter_urlfilter":                                {"webfilter/urlfilter", false},
+	"fortios_webproxy_debugurl":                                  {"web-proxy/debug-url", false},
+	"fortios_webproxy_explicit":                                  {"web-proxy/explicit", true},
+	"fortios_webproxy_forwardserver":                             {"web-proxy/forward-server", false},
+	"fortios_webproxy_forwardservergroup":                        {"web-proxy/forward-server-group", false},
+	"fortios_webproxy_global":                                    {"web-proxy/global", true},
+	"fortios_webproxy_profile":                                   {"web-proxy/profile", false},
+	"fortios_webproxy_urlmatch":                                  {"web-proxy/url-match", false},
+	"fortios_webproxy_wisp":                                      {"web-proxy/wisp", false},
+	"fortios_wirelesscontroller_accesscontrollist":               {"wireless-controller/access-control-list", false},
+	"fortios_wirelesscontroller_address":                         {"wireless-controller/address", false},
+	"fortios_wirelesscontroller_addrgrp":                         {"wireless-controller/addrgrp", false},
+	"fortios_wirelesscontroller_apcfgprofile":                    {"wireless-controller/apcfg-profile", false},
+	"fortios_wirelesscontroller_apstatus":                        {"wireless-controller/ap-status", false},
+	"fortios_wirelesscontroller_arrpprofile":                     {"wireless-controller/arrp-profile", false},
+	"fortios_wirelesscontroller_bleprofile":                      {"wireless-controller/ble-profile", false},
+	"fortios_wirelesscontroller_bonjourprofile":                  {"wireless-controller/bonjour-profile", false},
+	"fortios_wirelesscontroller_global":                          {"wireless-controller/global", true},
+	"fortios_wirelesscontroller_intercontroller":                 {"wireless-controller/inter-controller", true},
+	"fortios_wirelesscontroller_log":                             {"wireless-controller/log", true},
+	"fortios_wirelesscontroller_mpskprofile":                     {"wireless-controller/mpsk-profile", false},
+	"fortios_wirelesscontroller_nacprofile":                      {"wireless-controller/nac-profile", false},
+	"fortios_wirelesscontroller_qosprofile":                      {"wireless-controller/qos-profile", false},
+	"fortios_wirelesscontroller_region":                          {"wireless-controller/region", false},
+	"fortios_wirelesscontroller_setting":                         {"wireless-controller/setting", true},
+	"fortios_wirelesscontroller_snmp":                            {"wireless-controller/snmp", true},
+	"fortios_wirelesscontroller_ssidpolicy":                      {"wireless-controller/ssid-policy", false},
+	"fortios_wirelesscontroller_syslogprofile":                   {"wireless-controller/syslog-profile", false},
+	"fortios_wirelesscontroller_timers":                          {"wireless-controller/timers", true},
+	"fortios_wirelesscontroller_utmprofile":                      {"wireless-controller/utm-profile", false},
+	"fortios_wirelesscontroller_vap":                             {"wireless-controller/vap", false},
+	"fortios_wirelesscontroller_vapgroup":                        {"wireless-controller/vap-group", false},
+	"fortios_wirelesscontroller_wagprofile":                      {"wireless-controller/wag-profile", false},
+	"fortios_wirelesscontroller_widsprofile":                     {"wireless-controller/wids-profile", false},
+	"fortios_wirelesscontroller_wtp":                             {"wireless-controller/wtp", false},
+	"fortios_wirelesscontroller_wtpgroup":                        {"wireless-controller/wtp-group", false},
+	"fortios_wirelesscontroller_wtpprofile":                      {"wireless-controller/wtp-profile", false},
+	"fortios_wirelesscontrollerhotspot20_anqp3gppcellular":       {"wireless-controller.hotspot20/anqp-3gpp-cellular", false},
+	"fortios_wirelesscontrollerhotspot20_anqpipaddresstype":      {"wireless-controller.hotspot20/anqp-ip-address-type", false},
+	"fortios_wirelesscontrollerhotspot20_anqpnairealm":           {"wireless-controller.hotspot20/anqp-nai-realm", false},
+	"fortios_wirelesscontrollerhotspot20_anqpnetworkauthtype":    {"wireless-controller.hotspot20/anqp-network-auth-type", false},
+	"fortios_wirelesscontrollerhotspot20_anqproamingconsortium":  {"wireless-controller.hotspot20/anqp-roaming-consortium", false},
+	"fortios_wirelesscontrollerhotspot20_anqpvenuename":          {"wireless-controller.hotspot20/anqp-venue-name", false},
+	"fortios_wirelesscontrollerhotspot20_anqpvenueurl":           {"wireless-controller.hotspot20/anqp-venue-url", false},
+	"fortios_wirelesscontrollerhotspot20_h2qpadviceofcharge":     {"wireless-controller.hotspot20/h2qp-advice-of-charge", false},
+	"fortios_wirelesscontrollerhotspot20_h2qpconncapability":     {"wireless-controller.hotspot20/h2qp-conn-capability", false},
+	"fortios_wirelesscontrollerhotspot20_h2qpoperatorname":       {"wireless-controller.hotspot20/h2qp-operator-name", false},
+	"fortios_wirelesscontrollerhotspot20_h2qposuprovider":        {"wireless-controller.hotspot20/h2qp-osu-provider", false},
+	"fortios_wirelesscontrollerhotspot20_h2qposuprovidernai":     {"wireless-controller.hotspot20/h2qp-osu-provider-nai", false},
+	"fortios_wirelesscontrollerhotspot20_h2qptermsandconditions": {"wireless-controller.hotspot20/h2qp-terms-and-conditions", false},
+	"fortios_wirelesscontrollerhotspot20_h2qpwanmetric":          {"wireless-controller.hotspot20/h2qp-wan-metric", false},
+	"fortios_wirelesscontrollerhotspot20_hsprofile":              {"wireless-controller.hotspot20/hs-profile", false},
+	"fortios_wirelesscontrollerhotspot20_icon":                   {"wireless-controller.hotspot20/icon", false},
+	"fortios_wirelesscontrollerhotspot20_qosmap":                 {"wireless-controller.hotspot20/qos-map", false},
+}
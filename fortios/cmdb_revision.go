@@ -0,0 +1,223 @@
+package fortios
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Each refresh records in object_revision the revision of the object read
+// from FortiOS, the "revision" FortiOS returns with the object or else a hash
+// of the object. The updates and deletions read the object again and, if its
+// revision changed, compare it with the state: they fail if an attribute they
+// write or delete was changed by others in the meantime, unless the provider
+// argument overwrite_concurrent_changes is set. The attributes already set to
+// their planned value, such as a renamed address propagated into its groups,
+// are not conflicts.
+
+// configureCmdbRevision adds the detection of the concurrent changes to the
+// resource name
+func configureCmdbRevision(name string, r *schema.Resource, t cmdbResource) {
+	if r.Create == nil || r.Read == nil || r.Update == nil {
+		return
+	}
+
+	r.Schema["object_revision"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	create, read, update, del := r.Create, r.Read, r.Update, r.Delete
+
+	// the revision is taken from the read the functions end with
+	saved := func(fn func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		return func(d *schema.ResourceData, m interface{}) error {
+			var err error
+			result := cmdbReadObject(d, m, t, func() {
+				err = fn(d, m)
+			})
+			if err != nil {
+				return err
+			}
+			cmdbSaveRevision(d, m, result)
+			return nil
+		}
+	}
+
+	r.Create = saved(create)
+	r.Read = saved(read)
+
+	save := saved(update)
+	r.Update = func(d *schema.ResourceData, m interface{}) error {
+		if err := cmdbCheckRevision(name, d, m, r, t, read, false); err != nil {
+			return fmt.Errorf("Error updating %s resource: %v", name, err)
+		}
+		return save(d, m)
+	}
+
+	if del != nil {
+		r.Delete = func(d *schema.ResourceData, m interface{}) error {
+			if err := cmdbCheckRevision(name, d, m, r, t, read, true); err != nil {
+				return fmt.Errorf("Error deleting %s resource: %v", name, err)
+			}
+			return del(d, m)
+		}
+	}
+}
+
+// cmdbObjectRevision returns the revision of the object of the response
+// result, "" if there is no object
+func cmdbObjectRevision(result map[string]interface{}) string {
+	o := cmdbResultObject(result)
+	if o == nil {
+		return ""
+	}
+	if rev, ok := result["revision"].(string); ok && rev != "" {
+		return rev
+	}
+
+	return cmdbRevision(o)
+}
+
+// cmdbRevision returns the hash of the object o, without the metadata
+// attributes "q_..." FortiOS adds to the objects
+func cmdbRevision(o map[string]interface{}) string {
+	obj := make(map[string]interface{}, len(o))
+	for k, v := range o {
+		if !strings.HasPrefix(k, "q_") {
+			obj[k] = v
+		}
+	}
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return ""
+	}
+
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:])
+}
+
+func cmdbSaveRevision(d *schema.ResourceData, m interface{}, result map[string]interface{}) {
+	if d.Id() == "" {
+		return
+	}
+
+	f := m.(*FortiClient)
+	if f.OverwriteConcurrentChanges {
+		d.Set("object_revision", "")
+		return
+	}
+
+	if result == nil {
+		log.Printf("[WARN] cannot read the revision of resource (%s)", d.Id())
+		return
+	}
+
+	d.Set("object_revision", cmdbObjectRevision(result))
+}
+
+// cmdbCheckRevision reads the object of d with read and returns an error if
+// the attributes the update, or the deletion if deleting, is about to
+// overwrite were changed since the refresh of the plan
+func cmdbCheckRevision(name string, d *schema.ResourceData, m interface{}, r *schema.Resource, t cmdbResource, read schema.ReadFunc, deleting bool) error {
+	f := m.(*FortiClient)
+	if f.OverwriteConcurrentChanges || d.Id() == "" {
+		return nil
+	}
+
+	// the revision is recorded by the refresh, there is none for the objects
+	// read by an older provider
+	want, _ := d.Get("object_revision").(string)
+	if want == "" {
+		return nil
+	}
+
+	current := r.Data(d.State())
+	var err error
+	result := cmdbReadObject(current, m, t, func() {
+		err = read(current, m)
+	})
+	if err != nil {
+		log.Printf("[WARN] cannot read the revision of resource (%s): %v", d.Id(), err)
+		return nil
+	}
+
+	// a deleted object is left to the update or deletion
+	if current.Id() == "" || result == nil || cmdbObjectRevision(result) == want {
+		return nil
+	}
+
+	configured := make(map[string]bool)
+	if l, ok := d.Get("configured_attributes").([]interface{}); ok {
+		for _, k := range l {
+			if s, ok := k.(string); ok {
+				configured[s] = true
+			}
+		}
+	}
+
+	var changed []string
+	for k, s := range r.Schema {
+		if cmdbLocalAttributes[k] || (!s.Optional && !s.Required) {
+			continue
+		}
+
+		old, planned := d.GetChange(k)
+		if deleting {
+			// the sub-tables are references to other objects, which FortiOS
+			// and the membership resources update on their own
+			if _, ok := s.Elem.(*schema.Resource); ok {
+				continue
+			}
+			if len(configured) != 0 && !configured[k] {
+				continue
+			}
+			planned = old
+		} else if !f.FullUpdateResources[name] && !d.HasChange(k) {
+			continue
+		}
+
+		v := cmdbRevisionValue(current.Get(k))
+		if !reflect.DeepEqual(v, cmdbRevisionValue(old)) && !reflect.DeepEqual(v, cmdbRevisionValue(planned)) {
+			changed = append(changed, k)
+		}
+	}
+
+	if len(changed) != 0 {
+		sort.Strings(changed)
+		return fmt.Errorf("%s of %s was modified outside Terraform since plan, run terraform plan again to review the changes, or set the provider argument overwrite_concurrent_changes to overwrite them", strings.Join(changed, ", "), d.Id())
+	}
+
+	return nil
+}
+
+// cmdbRevisionValue returns the value v of an attribute with its sets turned
+// into lists, to be compared
+func cmdbRevisionValue(v interface{}) interface{} {
+	switch x := v.(type) {
+	case *schema.Set:
+		return cmdbRevisionValue(x.List())
+	case []interface{}:
+		l := make([]interface{}, len(x))
+		for i, e := range x {
+			l[i] = cmdbRevisionValue(e)
+		}
+		return l
+	case map[string]interface{}:
+		o := make(map[string]interface{}, len(x))
+		for k, e := range x {
+			o[k] = cmdbRevisionValue(e)
+		}
+		return o
+	}
+
+	return v
+}
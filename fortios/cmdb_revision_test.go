@@ -0,0 +1,200 @@
+package fortios
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+type testRoundTripper func(*http.Request) (*http.Response, error)
+
+func (fn testRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+func TestCmdbCheckRevision(t *testing.T) {
+	state := map[string]interface{}{
+		"name":                  "grp",
+		"comment":               "a",
+		"color":                 1,
+		"member":                []interface{}{map[string]interface{}{"name": "addr1"}},
+		"object_revision":       "1",
+		"configured_attributes": []interface{}{"comment", "member", "name"},
+	}
+
+	cases := []struct {
+		name     string
+		config   map[string]interface{}
+		current  map[string]interface{}
+		full     bool
+		deleting bool
+		err      string
+	}{
+		{
+			name:    "same revision",
+			config:  map[string]interface{}{"comment": "b"},
+			current: map[string]interface{}{"revision": "1", "comment": "x", "color": 1},
+		},
+		{
+			name:    "other attribute changed",
+			config:  map[string]interface{}{"comment": "b"},
+			current: map[string]interface{}{"revision": "2", "comment": "a", "color": 2},
+		},
+		{
+			name:    "attribute changed",
+			config:  map[string]interface{}{"comment": "b"},
+			current: map[string]interface{}{"revision": "2", "comment": "x", "color": 1},
+			err:     "comment of grp was modified",
+		},
+		{
+			name:    "attribute already planned",
+			config:  map[string]interface{}{"comment": "b"},
+			current: map[string]interface{}{"revision": "2", "comment": "b", "color": 1},
+		},
+		{
+			name:    "full update",
+			config:  map[string]interface{}{"comment": "b"},
+			current: map[string]interface{}{"revision": "2", "comment": "a", "color": 2},
+			full:    true,
+			err:     "color of grp was modified",
+		},
+		{
+			name:    "no revision",
+			config:  map[string]interface{}{"comment": "b"},
+			current: map[string]interface{}{"comment": "x", "color": 1},
+			err:     "comment of grp was modified",
+		},
+		{
+			name:     "deleted member",
+			current:  map[string]interface{}{"revision": "2", "comment": "a", "color": 1, "member": []interface{}{}},
+			deleting: true,
+		},
+		{
+			name:     "deleted unconfigured attribute changed",
+			current:  map[string]interface{}{"revision": "2", "comment": "a", "color": 2},
+			deleting: true,
+		},
+		{
+			name:     "deleted attribute changed",
+			current:  map[string]interface{}{"revision": "2", "comment": "x", "color": 1},
+			deleting: true,
+			err:      "comment of grp was modified",
+		},
+		{
+			name:    "object deleted",
+			config:  map[string]interface{}{"comment": "b"},
+			current: nil,
+		},
+	}
+
+	for _, c := range cases {
+		r := &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name":    {Type: schema.TypeString, Required: true},
+				"comment": {Type: schema.TypeString, Optional: true},
+				"color":   {Type: schema.TypeInt, Optional: true, Computed: true},
+				"member": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {Type: schema.TypeString, Optional: true},
+						},
+					},
+				},
+				"object_revision":       {Type: schema.TypeString, Computed: true},
+				"configured_attributes": {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			},
+		}
+
+		f := &FortiClient{
+			Client:              &forticlient.FortiSDKClient{},
+			FullUpdateResources: map[string]bool{"fortios_firewall_addrgrp": c.full},
+		}
+		f.cmdbReads = &cmdbReadTransport{base: testRoundTripper(func(req *http.Request) (*http.Response, error) {
+			result := map[string]interface{}{"http_status": 404}
+			if c.current != nil {
+				o := map[string]interface{}{"name": "grp", "member": []interface{}{map[string]interface{}{"name": "addr1"}}}
+				for k, v := range c.current {
+					if k != "revision" {
+						o[k] = v
+					}
+				}
+				result = map[string]interface{}{"http_status": 200, "revision": c.current["revision"], "results": []interface{}{o}}
+			}
+			b, _ := json.Marshal(result)
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader(b)), Request: req}, nil
+		})}
+
+		read := func(d *schema.ResourceData, m interface{}) error {
+			resp, err := (&http.Client{Transport: m.(*FortiClient).cmdbReads}).Get("https://fortigate/api/v2/cmdb/firewall/addrgrp/" + d.Id() + "?vdom=")
+			if err != nil {
+				return err
+			}
+			defer resp.Body.Close()
+
+			var result map[string]interface{}
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				return err
+			}
+			o := cmdbResultObject(result)
+			if o == nil {
+				d.SetId("")
+				return nil
+			}
+			for _, k := range []string{"name", "comment", "color", "member"} {
+				if v, ok := o[k]; ok {
+					d.Set(k, v)
+				}
+			}
+			return nil
+		}
+
+		var err error
+		check := func(d *schema.ResourceData, m interface{}) error {
+			err = cmdbCheckRevision("fortios_firewall_addrgrp", d, m, r, cmdbResource{path: "firewall/addrgrp"}, read, c.deleting)
+			return nil
+		}
+		r.Update, r.Delete = check, check
+
+		d := r.Data(nil)
+		d.SetId("grp")
+		for k, v := range state {
+			d.Set(k, v)
+		}
+		s := d.State()
+
+		diff := &terraform.InstanceDiff{Destroy: true}
+		if !c.deleting {
+			config := map[string]interface{}{"name": "grp", "member": state["member"]}
+			for k, v := range c.config {
+				config[k] = v
+			}
+			var derr error
+			diff, derr = r.Diff(context.Background(), s, terraform.NewResourceConfigRaw(config), f)
+			if derr != nil {
+				t.Fatalf("%s: cannot compute the diff: %v", c.name, derr)
+			}
+		}
+
+		if _, diags := r.Apply(context.Background(), s, diff, f); diags.HasError() {
+			t.Fatalf("%s: cannot apply the diff: %v", c.name, diags)
+		}
+
+		switch {
+		case c.err == "" && err != nil:
+			t.Errorf("%s: cmdbCheckRevision() = %v, want no error", c.name, err)
+		case c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)):
+			t.Errorf("%s: cmdbCheckRevision() = %v, want %q", c.name, err, c.err)
+		}
+	}
+}
@@ -0,0 +1,306 @@
+package fortios
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// cmdbLocalAttributes are the resource arguments that are handled by the
+// provider and never sent to FortiOS
+var cmdbLocalAttributes = map[string]bool{
+	"vdomparam":             true,
+	"dynamic_sort_subtable": true,
+	"autogenerated":         true,
+	"object_revision":       true,
+	"configured_attributes": true,
+}
+
+// cmdbAttrAliases lists the resource arguments whose names don't follow
+// cmdbAttrName, indexed by the FortiOS attribute name
+var cmdbAttrAliases = map[string]string{
+	"logid": "logid_block",
+}
+
+var cmdbAttrNameReplacer = strings.NewReplacer("-", "_", ".", "", "+", "", "(", "", ")", "", " ", "_")
+
+// cmdbAttrName converts the name of a FortiOS attribute, such as "start-ip",
+// to the name of the resource argument, such as "start_ip"
+func cmdbAttrName(name string, top bool) string {
+	n := cmdbAttrNameReplacer.Replace(strings.ToLower(name))
+
+	if n != "" && n[0] >= '0' && n[0] <= '9' {
+		n = "n" + n
+	}
+
+	if top && n == "id" {
+		n = "fosid"
+	}
+
+	return n
+}
+
+// cmdbAttrNames maps the resource arguments found in s to the FortiOS
+// attributes of children
+func cmdbAttrNames(children map[string]*cmdbAttrSchema, s map[string]*schema.Schema, top bool) map[string]*cmdbAttrSchema {
+	names := make(map[string]*cmdbAttrSchema, len(children))
+
+	for k, v := range children {
+		n := cmdbAttrName(k, top)
+		if _, ok := s[n]; !ok {
+			if a, ok := cmdbAttrAliases[k]; ok {
+				n = a
+			}
+		}
+		names[n] = v
+	}
+
+	return names
+}
+
+// cmdbSchemaRead is a read of a CMDB schema in progress
+type cmdbSchemaRead struct {
+	done chan struct{}
+	ts   *cmdbTableSchema
+}
+
+// CmdbSchema returns the schema of the CMDB table at path as served by the
+// connected FortiGate in the vdom vdomparam, the VDOMs can have different
+// features enabled. Schemas are read once and cached for the lifetime of the
+// provider, the concurrent callers wait for the same read. nil is returned
+// if the device doesn't serve one, or if the read failed: the failures are
+// not cached and the next caller reads the schema again.
+func (f *FortiClient) CmdbSchema(path, vdomparam string) *cmdbTableSchema {
+	key := vdomparam + "/" + path
+
+	f.cmdbSchemasLock.Lock()
+	if ts, ok := f.cmdbSchemas[key]; ok {
+		f.cmdbSchemasLock.Unlock()
+		return ts
+	}
+	if r, ok := f.cmdbSchemaReads[key]; ok {
+		f.cmdbSchemasLock.Unlock()
+		<-r.done
+		return r.ts
+	}
+
+	r := &cmdbSchemaRead{done: make(chan struct{})}
+	if f.cmdbSchemaReads == nil {
+		f.cmdbSchemaReads = make(map[string]*cmdbSchemaRead)
+	}
+	f.cmdbSchemaReads[key] = r
+	f.cmdbSchemasLock.Unlock()
+
+	ts, err := cmdbReadSchema(f.Client, path, vdomparam)
+
+	f.cmdbSchemasLock.Lock()
+	delete(f.cmdbSchemaReads, key)
+	if err == nil || errors.Is(err, errCmdbNoSchema) {
+		if f.cmdbSchemas == nil {
+			f.cmdbSchemas = make(map[string]*cmdbTableSchema)
+		}
+		f.cmdbSchemas[key] = ts
+	} else {
+		log.Printf("[WARN] cannot read the schema of %s, it is not checked: %v", path, err)
+	}
+	f.cmdbSchemasLock.Unlock()
+
+	r.ts = ts
+	close(r.done)
+
+	return ts
+}
+
+// cmdbVdomparam returns the vdomparam argument of the resource d, which is a
+// *schema.ResourceData or a *schema.ResourceDiff
+func cmdbVdomparam(d interface {
+	GetOk(string) (interface{}, bool)
+}) string {
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			return s
+		}
+	}
+
+	return ""
+}
+
+// errCmdbUnsupportedAttr reports an argument the table doesn't have on the
+// FortiGate, which is an error or a warning depending on the provider argument
+// unsupported_attributes
+type errCmdbUnsupportedAttr string
+
+func (e errCmdbUnsupportedAttr) Error() string {
+	return string(e) + ": the attribute is not supported"
+}
+
+// cmdbSchemaCustomizeDiff checks the arguments set in the configuration of
+// a resource against the schema of its table on the connected FortiGate:
+// option values, integer ranges, string sizes and attributes the firmware
+// doesn't have are reported at plan time.
+func cmdbSchemaCustomizeDiff(r *schema.Resource, t cmdbResource) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		f, ok := m.(*FortiClient)
+		if !ok || f == nil || f.Client == nil || !f.SchemaValidation {
+			return nil
+		}
+
+		config := d.GetRawConfig()
+		if config.IsNull() || !config.IsKnown() {
+			return nil
+		}
+
+		ts := f.CmdbSchema(t.path, cmdbVdomparam(d))
+		if ts == nil || len(ts.Children) == 0 {
+			return nil
+		}
+
+		var msgs, warnings []string
+		for _, err := range cmdbValidateConfig(ts.Children, r.Schema, config, "", true) {
+			if _, ok := err.(errCmdbUnsupportedAttr); ok {
+				switch f.UnsupportedAttributes {
+				case "disable":
+					continue
+				case "warning":
+					warnings = append(warnings, err.Error())
+					continue
+				}
+			}
+			msgs = append(msgs, err.Error())
+		}
+
+		if len(warnings) != 0 {
+			sort.Strings(warnings)
+			addPlanWarning(ctx, fmt.Sprintf("Unsupported configuration for %s on FortiOS %s", t.path, f.Client.Fv), strings.Join(warnings, "\n"))
+		}
+
+		if len(msgs) == 0 {
+			return nil
+		}
+		sort.Strings(msgs)
+
+		return fmt.Errorf("invalid configuration for %s on FortiOS %s:\n%s", t.path, f.Client.Fv, strings.Join(msgs, "\n"))
+	}
+}
+
+func cmdbValidateConfig(children map[string]*cmdbAttrSchema, s map[string]*schema.Schema, v cty.Value, pre string, top bool) (errs []error) {
+	if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() {
+		return nil
+	}
+
+	names := cmdbAttrNames(children, s, top)
+
+	for k, ks := range s {
+		if cmdbLocalAttributes[k] || !v.Type().HasAttribute(k) {
+			continue
+		}
+
+		av := v.GetAttr(k)
+		if av.IsNull() || !av.IsKnown() {
+			continue
+		}
+
+		key := pre + k
+		a, ok := names[k]
+		if !ok {
+			if ks.Required || ks.Optional {
+				errs = append(errs, errCmdbUnsupportedAttr(key))
+			}
+			continue
+		}
+
+		switch ks.Type {
+		case schema.TypeList, schema.TypeSet:
+			elem, ok := ks.Elem.(*schema.Resource)
+			if !ok || !av.CanIterateElements() {
+				continue
+			}
+			for it := av.ElementIterator(); it.Next(); {
+				i, ev := it.Element()
+				ekey := key + "."
+				if i.Type() == cty.Number {
+					idx, _ := i.AsBigFloat().Int64()
+					ekey = fmt.Sprintf("%s.%d.", key, idx)
+				}
+				errs = append(errs, cmdbValidateConfig(a.Children, elem.Schema, ev, ekey, false)...)
+			}
+		case schema.TypeString:
+			if av.Type() == cty.String {
+				errs = append(errs, cmdbValidateValue(a, key, av.AsString())...)
+			}
+		case schema.TypeInt:
+			if av.Type() == cty.Number {
+				errs = append(errs, cmdbValidateNumber(a, key, av.AsBigFloat())...)
+			}
+		}
+	}
+
+	return errs
+}
+
+func cmdbValidateValue(a *cmdbAttrSchema, key, v string) (errs []error) {
+	if v == "" {
+		return nil
+	}
+
+	switch a.Type {
+	case "option":
+		if len(a.Options) == 0 {
+			return nil
+		}
+
+		opts := make([]string, 0, len(a.Options))
+		valid := make(map[string]bool, len(a.Options))
+		for _, o := range a.Options {
+			opts = append(opts, o.Name)
+			valid[o.Name] = true
+		}
+
+		vs := []string{v}
+		if a.MultipleValues {
+			vs = strings.Fields(v)
+		}
+
+		for _, i := range vs {
+			if !valid[strings.Trim(i, "\"")] {
+				errs = append(errs, fmt.Errorf("%s: %q is not a valid value, expected one of: %s", key, i, strings.Join(opts, ", ")))
+			}
+		}
+	case "string", "var-string", "password", "user":
+		if a.Size > 0 && len(v) > a.Size {
+			errs = append(errs, fmt.Errorf("%s: the value is %d characters long, at most %d are allowed", key, len(v), a.Size))
+		}
+	case "integer":
+		bf, _, err := big.ParseFloat(v, 10, 64, big.ToNearestEven)
+		if err == nil {
+			errs = append(errs, cmdbValidateNumber(a, key, bf)...)
+		}
+	}
+
+	return errs
+}
+
+func cmdbValidateNumber(a *cmdbAttrSchema, key string, v *big.Float) (errs []error) {
+	if a.Type != "integer" {
+		return nil
+	}
+
+	f, _ := v.Float64()
+
+	if a.MinValue != nil && a.MaxValue != nil && (f < *a.MinValue || f > *a.MaxValue) {
+		errs = append(errs, fmt.Errorf("%s: %v is out of the range %.0f - %.0f", key, f, *a.MinValue, *a.MaxValue))
+	} else if a.MinValue != nil && f < *a.MinValue {
+		errs = append(errs, fmt.Errorf("%s: %v is less than %.0f", key, f, *a.MinValue))
+	} else if a.MaxValue != nil && f > *a.MaxValue {
+		errs = append(errs, fmt.Errorf("%s: %v is greater than %.0f", key, f, *a.MaxValue))
+	}
+
+	return errs
+}
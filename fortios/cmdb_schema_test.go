@@ -0,0 +1,93 @@
+package fortios
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCmdbSchema(t *testing.T) {
+	var reads, failures int32
+	release := make(chan struct{})
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		result := map[string]interface{}{"status": "success", "http_status": 200, "version": "v7.0.5", "serial": "FGVM"}
+
+		if r.URL.Query().Get("action") == "schema" {
+			atomic.AddInt32(&reads, 1)
+			switch {
+			case strings.HasSuffix(r.URL.Path, "/firewall/address"):
+				<-release
+				result["results"] = map[string]interface{}{"name": "address", "category": "table"}
+			case strings.HasSuffix(r.URL.Path, "/firewall/addrgrp") && atomic.AddInt32(&failures, 1) == 1:
+				result["status"], result["http_status"] = "error", 500
+			case strings.HasSuffix(r.URL.Path, "/firewall/addrgrp"):
+				result["results"] = map[string]interface{}{"name": "addrgrp", "category": "table"}
+			default:
+				result["status"], result["http_status"] = "error", 404
+			}
+		}
+
+		json.NewEncoder(w).Encode(result)
+	}))
+	defer server.Close()
+
+	insecure := true
+	c, err := (&Config{Hostname: strings.TrimPrefix(server.URL, "https://"), Token: "x", Insecure: &insecure}).CreateClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := c.(*FortiClient)
+
+	// the concurrent callers share a single read
+	var wg sync.WaitGroup
+	schemas := make([]*cmdbTableSchema, 4)
+	for i := range schemas {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			schemas[i] = f.CmdbSchema("firewall/address", "")
+		}(i)
+	}
+	for {
+		f.cmdbSchemasLock.Lock()
+		started := f.cmdbSchemaReads["/firewall/address"] != nil
+		f.cmdbSchemasLock.Unlock()
+		if started {
+			break
+		}
+		runtime.Gosched()
+	}
+	close(release)
+	wg.Wait()
+
+	for i, ts := range schemas {
+		if ts == nil || ts.Name != "address" {
+			t.Errorf("CmdbSchema() of caller %d = %v, want the schema of firewall/address", i, ts)
+		}
+	}
+	if n := atomic.LoadInt32(&reads); n != 1 {
+		t.Errorf("concurrent callers read the schema %d times, want 1", n)
+	}
+
+	// the failures are not cached
+	if ts := f.CmdbSchema("firewall/addrgrp", ""); ts != nil {
+		t.Errorf("CmdbSchema() after a failure = %v, want nil", ts)
+	}
+	if ts := f.CmdbSchema("firewall/addrgrp", ""); ts == nil || ts.Name != "addrgrp" {
+		t.Errorf("CmdbSchema() after a failure = %v, want the schema of firewall/addrgrp", ts)
+	}
+
+	// the tables without schema are
+	atomic.StoreInt32(&reads, 0)
+	f.CmdbSchema("firewall/unknown", "")
+	f.CmdbSchema("firewall/unknown", "")
+	if n := atomic.LoadInt32(&reads); n != 1 {
+		t.Errorf("the schema of a table without one was read %d times, want 1", n)
+	}
+}
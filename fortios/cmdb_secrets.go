@@ -0,0 +1,207 @@
+package fortios
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// FortiOS never returns the secrets, such as pre-shared keys and passwords,
+// only their ciphertext "ENC ...". For each secret it writes, the provider
+// keeps in the private state of the resource a hash of the ciphertext the
+// device stored and a fingerprint of the value written, an HMAC keyed with a
+// random salt of the resource. A refresh finding a different ciphertext means
+// the secret was changed out of band, and a secret in the state not matching
+// the fingerprint means it was not the one written, for example because the
+// apply rotating it failed: in both cases the secret is cleared from the
+// state so that the next plan writes it again. Only the top level secrets are
+// covered, not the secrets of the sub-tables.
+
+// cmdbSecretsKey is the key of the fingerprints in the private state
+const cmdbSecretsKey = "secret_fingerprints"
+
+// cmdbSecretFingerprints is what the private state keeps of the secrets of a
+// resource
+type cmdbSecretFingerprints struct {
+	Salt    string                           `json:"salt"`
+	Secrets map[string]cmdbSecretFingerprint `json:"secrets"`
+}
+
+// cmdbSecretFingerprint is what the private state keeps of a secret
+type cmdbSecretFingerprint struct {
+	// Written is the HMAC of the value written
+	Written string `json:"written"`
+	// Enc is the hash of the ciphertext stored by the device
+	Enc string `json:"enc"`
+}
+
+// cmdbSecretAttributes returns the top level write-only attributes of r
+func cmdbSecretAttributes(r *schema.Resource) []string {
+	var secrets []string
+
+	for k, s := range r.Schema {
+		if s.Sensitive && s.Type == schema.TypeString && (s.Optional || s.Required) {
+			secrets = append(secrets, k)
+		}
+	}
+	sort.Strings(secrets)
+
+	return secrets
+}
+
+// configureCmdbSecrets adds the drift detection of the write-only attributes
+// to r, if it has any. It needs the context of the calls for the private
+// state, and wraps the functions of r last.
+func configureCmdbSecrets(r *schema.Resource, t cmdbResource) {
+	secrets := cmdbSecretAttributes(r)
+	create, update, read := contextFunc(r.Create, r.CreateContext), contextFunc(r.Update, r.UpdateContext), contextFunc(r.Read, r.ReadContext)
+	if len(secrets) == 0 || create == nil || update == nil || read == nil {
+		return
+	}
+
+	write := func(fn contextCRUDFunc) contextCRUDFunc {
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			result := cmdbReadObject(d, m, t, func() {
+				diags = fn(ctx, d, m)
+			})
+			if !diags.HasError() {
+				cmdbSaveSecretFingerprints(ctx, d, result, secrets)
+			}
+			return diags
+		}
+	}
+
+	r.Create, r.CreateContext = nil, write(create)
+	r.Update, r.UpdateContext = nil, write(update)
+
+	r.Read = nil
+	r.ReadContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+		result := cmdbReadObject(d, m, t, func() {
+			diags = read(ctx, d, m)
+		})
+		if diags.HasError() {
+			return diags
+		}
+		if err := cmdbCheckSecretFingerprints(ctx, d, result); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+// cmdbSecrets returns the ciphertexts of the secrets of the object of the
+// response result, indexed by attribute
+func cmdbSecrets(result map[string]interface{}) map[string]string {
+	enc := make(map[string]string)
+	for k, v := range cmdbResultObject(result) {
+		if s, ok := v.(string); ok {
+			enc[cmdbAttrName(k, true)] = s
+		}
+	}
+
+	return enc
+}
+
+func cmdbSecretHash(v string) string {
+	h := sha256.Sum256([]byte(v))
+	return hex.EncodeToString(h[:])
+}
+
+func cmdbSecretHMAC(salt, v string) string {
+	h := hmac.New(sha256.New, []byte(salt))
+	h.Write([]byte(v))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func cmdbSaveSecretFingerprints(ctx context.Context, d *schema.ResourceData, result map[string]interface{}, secrets []string) {
+	p := getPrivateState(ctx)
+	if p == nil || d.Id() == "" {
+		return
+	}
+	if result == nil {
+		log.Printf("[WARN] cannot read the secrets of resource (%s)", d.Id())
+		p.Set(cmdbSecretsKey, nil)
+		return
+	}
+
+	var fingerprints cmdbSecretFingerprints
+	p.Get(cmdbSecretsKey, &fingerprints)
+	if fingerprints.Salt == "" {
+		salt := make([]byte, 32)
+		if _, err := rand.Read(salt); err != nil {
+			log.Printf("[WARN] cannot generate the salt of the secrets of resource (%s): %v", d.Id(), err)
+			p.Set(cmdbSecretsKey, nil)
+			return
+		}
+		fingerprints.Salt = hex.EncodeToString(salt)
+	}
+	fingerprints.Secrets = make(map[string]cmdbSecretFingerprint)
+
+	enc := cmdbSecrets(result)
+
+	for _, k := range secrets {
+		v, ok := d.Get(k).(string)
+		if !ok || v == "" || enc[k] == "" {
+			continue
+		}
+		fingerprints.Secrets[k] = cmdbSecretFingerprint{
+			Written: cmdbSecretHMAC(fingerprints.Salt, v),
+			Enc:     cmdbSecretHash(enc[k]),
+		}
+	}
+
+	if len(fingerprints.Secrets) == 0 {
+		p.Set(cmdbSecretsKey, nil)
+		return
+	}
+	p.Set(cmdbSecretsKey, fingerprints)
+}
+
+func cmdbCheckSecretFingerprints(ctx context.Context, d *schema.ResourceData, result map[string]interface{}) error {
+	p := getPrivateState(ctx)
+	if d.Id() == "" || result == nil {
+		return nil
+	}
+
+	var fingerprints cmdbSecretFingerprints
+	if !p.Get(cmdbSecretsKey, &fingerprints) || len(fingerprints.Secrets) == 0 {
+		return nil
+	}
+
+	enc := cmdbSecrets(result)
+
+	for k, fp := range fingerprints.Secrets {
+		v, _ := d.Get(k).(string)
+		switch {
+		case cmdbSecretHash(enc[k]) != fp.Enc:
+			log.Printf("[WARN] %s of resource (%s) was changed outside of Terraform", k, d.Id())
+		case cmdbSecretHMAC(fingerprints.Salt, v) != fp.Written:
+			log.Printf("[WARN] %s of resource (%s) is not the value last written", k, d.Id())
+		default:
+			continue
+		}
+
+		if err := d.Set(k, ""); err != nil {
+			return fmt.Errorf("Error reading %s: %v", k, err)
+		}
+		delete(fingerprints.Secrets, k)
+	}
+
+	if len(fingerprints.Secrets) == 0 {
+		p.Set(cmdbSecretsKey, nil)
+		return nil
+	}
+	p.Set(cmdbSecretsKey, fingerprints)
+
+	return nil
+}
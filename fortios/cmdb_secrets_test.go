@@ -0,0 +1,101 @@
+package fortios
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCmdbCheckSecretFingerprints(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"name":      {Type: schema.TypeString, Required: true},
+		"psksecret": {Type: schema.TypeString, Optional: true, Sensitive: true},
+	}
+	result := func(enc string) map[string]interface{} {
+		return map[string]interface{}{
+			"results": []interface{}{map[string]interface{}{"name": "vpn1", "psksecret": enc}},
+		}
+	}
+
+	cases := []struct {
+		name    string
+		written string
+		state   string
+		enc     string
+		want    string
+		kept    bool
+	}{
+		{"unchanged", "secret1", "secret1", "ENC a", "secret1", true},
+		{"changed outside Terraform", "secret1", "secret1", "ENC b", "", false},
+		{"not the value written", "secret1", "secret2", "ENC a", "", false},
+		{"no longer returned", "secret1", "secret1", "", "", false},
+	}
+
+	for _, c := range cases {
+		p := &privateState{}
+		ctx := context.WithValue(context.Background(), privateStateCtxKey{}, p)
+
+		d := schema.TestResourceDataRaw(t, s, map[string]interface{}{"name": "vpn1", "psksecret": c.written})
+		d.SetId("vpn1")
+		cmdbSaveSecretFingerprints(ctx, d, result("ENC a"), []string{"psksecret"})
+
+		var fingerprints cmdbSecretFingerprints
+		if !p.Get(cmdbSecretsKey, &fingerprints) {
+			t.Fatalf("%s: no fingerprint saved", c.name)
+		}
+		fp := fingerprints.Secrets["psksecret"]
+		if fingerprints.Salt == "" || fp.Written == "" || fp.Written == cmdbSecretHash(c.written) || fp.Enc != cmdbSecretHash("ENC a") {
+			t.Errorf("%s: saved fingerprint %+v", c.name, fingerprints)
+		}
+
+		d.Set("psksecret", c.state)
+		if err := cmdbCheckSecretFingerprints(ctx, d, result(c.enc)); err != nil {
+			t.Fatalf("%s: cmdbCheckSecretFingerprints() = %v", c.name, err)
+		}
+
+		if got := d.Get("psksecret").(string); got != c.want {
+			t.Errorf("%s: psksecret = %q, want %q", c.name, got, c.want)
+		}
+		var after cmdbSecretFingerprints
+		if kept := p.Get(cmdbSecretsKey, &after); kept != c.kept {
+			t.Errorf("%s: fingerprints kept = %v, want %v", c.name, kept, c.kept)
+		}
+	}
+}
+
+func TestCmdbSaveSecretFingerprintsSalt(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"psksecret": {Type: schema.TypeString, Optional: true, Sensitive: true},
+	}
+	result := map[string]interface{}{
+		"results": []interface{}{map[string]interface{}{"psksecret": "ENC a"}},
+	}
+
+	written := make(map[string]bool)
+	for i := 0; i < 2; i++ {
+		p := &privateState{}
+		ctx := context.WithValue(context.Background(), privateStateCtxKey{}, p)
+
+		d := schema.TestResourceDataRaw(t, s, map[string]interface{}{"psksecret": "secret1"})
+		d.SetId("vpn1")
+		cmdbSaveSecretFingerprints(ctx, d, result, []string{"psksecret"})
+
+		var fingerprints cmdbSecretFingerprints
+		p.Get(cmdbSecretsKey, &fingerprints)
+		written[fingerprints.Secrets["psksecret"].Written] = true
+
+		// the salt is kept by the next writes
+		cmdbSaveSecretFingerprints(ctx, d, result, []string{"psksecret"})
+		var again cmdbSecretFingerprints
+		p.Get(cmdbSecretsKey, &again)
+		if again.Salt != fingerprints.Salt || again.Secrets["psksecret"] != fingerprints.Secrets["psksecret"] {
+			t.Errorf("fingerprints after a second write = %+v, want %+v", again, fingerprints)
+		}
+	}
+
+	// the same secret has different fingerprints in different resources
+	if len(written) != 2 {
+		t.Errorf("the fingerprints of the same secret in two resources are equal")
+	}
+}
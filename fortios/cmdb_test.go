@@ -0,0 +1,122 @@
+package fortios
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCmdbDecodeStream(t *testing.T) {
+	errStop := errors.New("stop")
+
+	cases := []struct {
+		name    string
+		body    string
+		stopAt  int
+		result  map[string]interface{}
+		entries []string
+		n       int
+		err     bool
+	}{
+		{
+			name:    "entries",
+			body:    `{"http_status":200,"results":[{"name":"a"},{"name":"b"}],"vdom":"root"}`,
+			result:  map[string]interface{}{"http_status": float64(200), "vdom": "root"},
+			entries: []string{"a", "b"},
+			n:       2,
+		},
+		{
+			name:   "no entry",
+			body:   `{"results":[],"status":"success"}`,
+			result: map[string]interface{}{"status": "success"},
+		},
+		{
+			name:   "no results",
+			body:   `{"http_status":404,"status":"error"}`,
+			result: map[string]interface{}{"http_status": float64(404), "status": "error"},
+		},
+		{
+			name:    "stopped",
+			body:    `{"results":[{"name":"a"},{"name":"b"},{"name":"c"}]}`,
+			stopAt:  2,
+			entries: []string{"a", "b"},
+			n:       2,
+			err:     true,
+		},
+		{
+			name: "not an object",
+			body: `[{"name":"a"}]`,
+			err:  true,
+		},
+		{
+			name: "results not a list",
+			body: `{"results":{"name":"a"}}`,
+			err:  true,
+		},
+		{
+			name:    "truncated",
+			body:    `{"results":[{"name":"a"},{"na`,
+			entries: []string{"a"},
+			n:       1,
+			err:     true,
+		},
+		{
+			name: "empty",
+			err:  true,
+		},
+	}
+
+	for _, c := range cases {
+		var entries []string
+		result, n, err := cmdbDecodeStream(strings.NewReader(c.body), func(o map[string]interface{}) error {
+			name, _ := o["name"].(string)
+			entries = append(entries, name)
+			if len(entries) == c.stopAt {
+				return errStop
+			}
+			return nil
+		})
+
+		if (err != nil) != c.err {
+			t.Errorf("%s: cmdbDecodeStream() error = %v, want error %v", c.name, err, c.err)
+		}
+		if c.stopAt != 0 && !errors.Is(err, errStop) {
+			t.Errorf("%s: cmdbDecodeStream() error = %v, want %v", c.name, err, errStop)
+		}
+		if !c.err && !reflect.DeepEqual(result, c.result) {
+			t.Errorf("%s: cmdbDecodeStream() result = %v, want %v", c.name, result, c.result)
+		}
+		if !reflect.DeepEqual(entries, c.entries) {
+			t.Errorf("%s: cmdbDecodeStream() entries = %v, want %v", c.name, entries, c.entries)
+		}
+		if n != c.n {
+			t.Errorf("%s: cmdbDecodeStream() n = %d, want %d", c.name, n, c.n)
+		}
+	}
+}
+
+func TestCmdbErrorFormat(t *testing.T) {
+	cases := []struct {
+		result map[string]interface{}
+		want   string
+	}{
+		{map[string]interface{}{"status": "success", "http_status": float64(200)}, ""},
+		{map[string]interface{}{"status": "error", "http_status": float64(404)}, "Resource Not Found - Unable to find the specified resource (404)"},
+		{map[string]interface{}{"status": "error", "http_status": float64(424), "cli_error": "entry not found"}, "Failed Dependency - Fail dependency can be duplicate resource, missing required parameter, missing required attribute, invalid attribute value (424)\nCli response: \nentry not found"},
+		{map[string]interface{}{"status": "error", "http_status": float64(418)}, "Unknow Error (418)"},
+		{map[string]interface{}{"status": "error"}, "\nbody"},
+		{nil, "\nbody"},
+	}
+
+	for _, c := range cases {
+		err := cmdbErrorFormat(c.result, "body")
+		got := ""
+		if err != nil {
+			got = err.Error()
+		}
+		if got != c.want {
+			t.Errorf("cmdbErrorFormat(%v) = %q, want %q", c.result, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package fortios
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// cmdbUpdateObject limits the object obj sent to update the resource name to
+// the attributes changed in the configuration, so that the attributes
+// changed on the FortiGate by others are not overwritten. The attributes
+// removed from the configuration are sent with their default in the CMDB
+// schema, or as null if it has none. The whole object is kept for the
+// resources listed in the provider argument full_update_resources.
+func cmdbUpdateObject(d *schema.ResourceData, m interface{}, name string, obj *map[string]interface{}) {
+	f, ok := m.(*FortiClient)
+	if !ok || f == nil || obj == nil || f.FullUpdateResources[name] {
+		return
+	}
+
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.Type().IsObjectType() {
+		return
+	}
+	ct := config.Type()
+
+	tfName := func(k string) string {
+		n := cmdbAttrName(k, true)
+		if !ct.HasAttribute(n) {
+			if a, ok := cmdbAttrAliases[k]; ok {
+				n = a
+			}
+		}
+		return n
+	}
+
+	for k := range *obj {
+		n := tfName(k)
+		if ct.HasAttribute(n) && !d.HasChange(n) {
+			delete(*obj, k)
+		}
+	}
+
+	t, ok := cmdbResources[name]
+	if !ok || f.Client == nil {
+		return
+	}
+
+	ts := f.CmdbSchema(t.path, cmdbVdomparam(d))
+	if ts == nil {
+		return
+	}
+
+	for k, a := range ts.Children {
+		if _, ok := (*obj)[k]; ok {
+			continue
+		}
+
+		n := tfName(k)
+		if !ct.HasAttribute(n) || cmdbLocalAttributes[n] || !d.HasChange(n) {
+			continue
+		}
+
+		if _, ok := d.GetOk(n); !ok {
+			log.Printf("[DEBUG] %s removed from the configuration of resource (%s), resetting it", n, d.Id())
+			(*obj)[k] = a.Default
+		}
+	}
+}
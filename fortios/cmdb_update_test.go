@@ -0,0 +1,220 @@
+package fortios
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestCmdbUpdateObject(t *testing.T) {
+	state := map[string]interface{}{
+		"name":    "grp",
+		"comment": "managed",
+		"color":   3,
+		"member": []interface{}{
+			map[string]interface{}{"name": "addr1"},
+		},
+		"tagging": []interface{}{
+			map[string]interface{}{
+				"name":     "t1",
+				"category": "c1",
+				"tags": []interface{}{
+					map[string]interface{}{"name": "tag1"},
+				},
+			},
+		},
+	}
+
+	// config returns the configuration of state with the changes of c, nil
+	// removing an argument
+	config := func(c map[string]interface{}) map[string]interface{} {
+		cfg := make(map[string]interface{})
+		for k, v := range state {
+			cfg[k] = v
+		}
+		for k, v := range c {
+			if v == nil {
+				delete(cfg, k)
+				continue
+			}
+			cfg[k] = v
+		}
+		return cfg
+	}
+
+	cases := []struct {
+		name     string
+		config   map[string]interface{}
+		defaults map[string]interface{}
+		full     bool
+		want     map[string]interface{}
+	}{
+		{
+			name:   "no change",
+			config: config(nil),
+			want:   map[string]interface{}{},
+		},
+		{
+			name:   "attribute changed",
+			config: config(map[string]interface{}{"comment": "changed"}),
+			want:   map[string]interface{}{"comment": "changed"},
+		},
+		{
+			name: "sub-table changed",
+			config: config(map[string]interface{}{
+				"member": []interface{}{
+					map[string]interface{}{"name": "addr1"},
+					map[string]interface{}{"name": "addr2"},
+				},
+			}),
+			want: map[string]interface{}{
+				"member": []map[string]interface{}{
+					{"name": "addr1"},
+					{"name": "addr2"},
+				},
+			},
+		},
+		{
+			name: "nested sub-table changed",
+			config: config(map[string]interface{}{
+				"tagging": []interface{}{
+					map[string]interface{}{
+						"name":     "t1",
+						"category": "c1",
+						"tags": []interface{}{
+							map[string]interface{}{"name": "tag2"},
+						},
+					},
+				},
+			}),
+			want: map[string]interface{}{
+				"tagging": []map[string]interface{}{
+					{
+						"name":     "t1",
+						"category": "c1",
+						"tags": []map[string]interface{}{
+							{"name": "tag2"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:     "attribute removed",
+			config:   config(map[string]interface{}{"comment": nil}),
+			defaults: map[string]interface{}{"comment": "none"},
+			want:     map[string]interface{}{"comment": "none"},
+		},
+		{
+			name:   "attribute removed without default",
+			config: config(map[string]interface{}{"comment": nil}),
+			want:   map[string]interface{}{"comment": nil},
+		},
+		{
+			name:   "full update",
+			config: config(map[string]interface{}{"comment": "changed"}),
+			full:   true,
+			want: map[string]interface{}{
+				"name":    "grp",
+				"comment": "changed",
+				"color":   3,
+				"member": []map[string]interface{}{
+					{"name": "addr1"},
+				},
+				"tagging": []map[string]interface{}{
+					{
+						"name":     "t1",
+						"category": "c1",
+						"tags": []map[string]interface{}{
+							{"name": "tag1"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		children := map[string]*cmdbAttrSchema{
+			"name":    {Name: "name", Category: "unitary"},
+			"comment": {Name: "comment", Category: "unitary"},
+			"color":   {Name: "color", Category: "unitary", Default: float64(0)},
+			"member":  {Name: "member", Category: "table"},
+			"tagging": {Name: "tagging", Category: "table"},
+		}
+		for k, v := range c.defaults {
+			children[k].Default = v
+		}
+
+		f := &FortiClient{
+			Client:              &forticlient.FortiSDKClient{},
+			FullUpdateResources: map[string]bool{"fortios_firewall_addrgrp": c.full},
+			cmdbSchemas: map[string]*cmdbTableSchema{
+				"/firewall/addrgrp": {Name: "addrgrp", Children: children},
+			},
+		}
+
+		got := testCmdbUpdateObject(t, c.config, state, f)
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: cmdbUpdateObject() = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+// testCmdbUpdateObject applies the configuration config to a
+// fortios_firewall_addrgrp in the state state, and returns the object its
+// update sends
+func testCmdbUpdateObject(t *testing.T, config, state map[string]interface{}, f *FortiClient) map[string]interface{} {
+	t.Helper()
+
+	r := resourceFirewallAddrgrp()
+
+	d := r.Data(nil)
+	d.SetId("grp")
+	for k, v := range state {
+		if err := d.Set(k, v); err != nil {
+			t.Fatalf("cannot set %s: %v", k, err)
+		}
+	}
+	s := d.State()
+
+	diff, err := r.Diff(context.Background(), s, terraform.NewResourceConfigRaw(config), f)
+	if err != nil {
+		t.Fatalf("cannot compute the diff: %v", err)
+	}
+	if diff == nil {
+		diff = terraform.NewInstanceDiff()
+	}
+
+	b, err := json.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff.RawConfig, err = ctyjson.Unmarshal(b, r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatalf("cannot convert the configuration: %v", err)
+	}
+
+	var got map[string]interface{}
+	r.Update = func(d *schema.ResourceData, m interface{}) error {
+		obj, err := getObjectFirewallAddrgrp(d, "")
+		if err != nil {
+			return err
+		}
+		cmdbUpdateObject(d, m, "fortios_firewall_addrgrp", obj)
+		got = *obj
+		return nil
+	}
+
+	if _, diags := r.Apply(context.Background(), s, diff, f); diags.HasError() {
+		t.Fatalf("cannot apply the diff: %v", diags)
+	}
+
+	return got
+}
@@ -0,0 +1,196 @@
+package fortios
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// cmdbVersionRange is the range of FortiOS versions supporting a resource or
+// an attribute: since is the first version having it and removed the first
+// version without it, an empty bound is open
+type cmdbVersionRange struct {
+	since   string
+	removed string
+	hint    string
+}
+
+//go:generate go run ../scripts/cmdbversions -resources cmdb_resources.go -schemas ../scripts/cmdbversions/schemas -o cmdb_versions_gen.go
+
+// The versions supporting each CMDB table and attribute, cmdbTableVersions
+// and cmdbTableAttrVersions, are generated from the CMDB schemas of FortiGates
+// running different FortiOS versions, see scripts/cmdbversions.
+
+// cmdbResourceVersions completes cmdbTableVersions with the hints given with
+// the resources, and the versions the schemas are missing for, indexed by
+// resource name
+var cmdbResourceVersions = map[string]cmdbVersionRange{
+	"fortios_firewall_accessproxy":        {since: "7.0.0"},
+	"fortios_firewallconsolidated_policy": {since: "6.2.0", removed: "7.0.0", hint: "use fortios_firewall_policy"},
+	"fortios_system_sdwan":                {since: "6.4.1", hint: "use fortios_system_virtualwanlink"},
+	"fortios_system_virtualwanlink":       {removed: "6.4.1", hint: "use fortios_system_sdwan"},
+}
+
+// cmdbAttrVersions completes cmdbTableAttrVersions in the same way, indexed
+// by resource name then by argument, the arguments of nested blocks are
+// written as block.argument
+var cmdbAttrVersions = map[string]map[string]cmdbVersionRange{
+	"fortios_firewall_policy": {
+		"ztna_status": {since: "7.0.0"},
+	},
+	"fortios_system_dns": {
+		"dns_over_tls": {since: "6.2.0"},
+	},
+	"fortios_system_settings": {
+		"consolidated_firewall_mode": {since: "6.2.0", removed: "7.0.0"},
+	},
+}
+
+// check returns an error naming the supported versions if v is out of the
+// range
+func (vr cmdbVersionRange) check(name string, v *version.Version) error {
+	var msg string
+
+	if vr.since != "" {
+		if since, err := version.NewVersion(vr.since); err == nil && v.LessThan(since) {
+			msg = fmt.Sprintf("%s requires FortiOS v%s or later", name, vr.since)
+		}
+	}
+
+	if vr.removed != "" {
+		if removed, err := version.NewVersion(vr.removed); err == nil && v.GreaterThanOrEqual(removed) {
+			msg = fmt.Sprintf("%s is not supported since FortiOS v%s", name, vr.removed)
+		}
+	}
+
+	if msg == "" {
+		return nil
+	}
+
+	if vr.hint != "" {
+		msg += ", " + vr.hint
+	}
+
+	return fmt.Errorf("%s", msg)
+}
+
+// cmdbVersionCustomizeDiff reports the resource and the arguments set in
+// its configuration that are not supported by the FortiOS version of the
+// connected FortiGate
+func cmdbVersionCustomizeDiff(name string, r *schema.Resource, t cmdbResource) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		f, ok := m.(*FortiClient)
+		if !ok || f == nil || f.Client == nil || f.VersionCheck == "disable" {
+			return nil
+		}
+
+		v, err := version.NewVersion(strings.TrimPrefix(f.Client.Fv, "v"))
+		if err != nil {
+			return nil
+		}
+
+		var msgs []string
+
+		vr, ok := cmdbResourceVersions[name]
+		if !ok {
+			vr = cmdbTableVersions[t.path]
+		}
+		if err := vr.check(name, v); err != nil {
+			msgs = append(msgs, err.Error())
+		}
+
+		config := d.GetRawConfig()
+		if !config.IsNull() && config.IsKnown() {
+			attrs := make(map[string]cmdbVersionRange)
+			for attr, vr := range cmdbTableAttrVersions[t.path] {
+				attrs[cmdbAttrPath(r.Schema, attr)] = vr
+			}
+			for attr, vr := range cmdbAttrVersions[name] {
+				attrs[attr] = vr
+			}
+
+			for attr, vr := range attrs {
+				if !cmdbConfigHasAttr(config, strings.Split(attr, ".")) {
+					continue
+				}
+				if err := vr.check(attr, v); err != nil {
+					msgs = append(msgs, err.Error())
+				}
+			}
+		}
+
+		if len(msgs) == 0 {
+			return nil
+		}
+		sort.Strings(msgs)
+
+		if f.VersionCheck == "warning" {
+			addPlanWarning(ctx, fmt.Sprintf("Unsupported configuration for %s on FortiOS %s", name, f.Client.Fv), strings.Join(msgs, "\n"))
+			return nil
+		}
+
+		return fmt.Errorf("unsupported configuration for %s on FortiOS %s:\n%s", name, f.Client.Fv, strings.Join(msgs, "\n"))
+	}
+}
+
+// cmdbAttrPath converts the path of a FortiOS attribute, such as
+// "sub-table.start-ip", to the path of the argument in the resource schema s,
+// such as "sub_table.start_ip"
+func cmdbAttrPath(s map[string]*schema.Schema, attr string) string {
+	parts := strings.Split(attr, ".")
+
+	for i, k := range parts {
+		n := cmdbAttrName(k, i == 0)
+		if _, ok := s[n]; !ok {
+			if a, ok := cmdbAttrAliases[k]; ok {
+				n = a
+			}
+		}
+		parts[i] = n
+
+		var next map[string]*schema.Schema
+		if ks, ok := s[n]; ok {
+			if elem, ok := ks.Elem.(*schema.Resource); ok {
+				next = elem.Schema
+			}
+		}
+		s = next
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// cmdbConfigHasAttr reports whether the attribute at path is set in v, for
+// nested blocks in any of their elements
+func cmdbConfigHasAttr(v cty.Value, path []string) bool {
+	if v.IsNull() || !v.IsKnown() {
+		return false
+	}
+
+	if len(path) == 0 {
+		return true
+	}
+
+	t := v.Type()
+	switch {
+	case t.IsObjectType():
+		if !t.HasAttribute(path[0]) {
+			return false
+		}
+		return cmdbConfigHasAttr(v.GetAttr(path[0]), path[1:])
+	case t.IsListType() || t.IsSetType():
+		for it := v.ElementIterator(); it.Next(); {
+			_, ev := it.Element()
+			if cmdbConfigHasAttr(ev, path) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,15 @@
+// Code generated by scripts/cmdbversions; DO NOT EDIT.
+
+package fortios
+
+// No CMDB schema was found under scripts/cmdbversions/schemas, only the
+// versions listed in cmdb_versions.go are checked.
+
+// cmdbTableVersions lists the CMDB tables only served by some FortiOS
+// versions, indexed by path
+var cmdbTableVersions = map[string]cmdbVersionRange{}
+
+// cmdbTableAttrVersions lists the attributes only supported by some FortiOS
+// versions, indexed by table path then by attribute, the attributes of
+// sub-tables are written as sub-table.attribute
+var cmdbTableAttrVersions = map[string]map[string]cmdbVersionRange{}
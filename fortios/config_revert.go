@@ -0,0 +1,204 @@
+package fortios
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// When config_revert_timeout is set, the provider switches the FortiGate to
+// the manual configuration save mode "revert" before its first write, and
+// keeps it for the whole run. Once the writes running concurrently complete,
+// it checks the FortiGate can still be reached through a new connection; if
+// it can't, the next writes fail and the FortiGate reverts the changes by
+// rebooting once the timeout expires. When the provider is closed after the
+// apply, it checks the FortiGate once more, saves the configuration and
+// restores the previous save mode. The configuration is also saved between
+// writes once half of the timeout has passed, so that long applies are not
+// reverted.
+
+// configureConfigRevert makes the writes of r run under the configuration
+// revert mode
+func configureConfigRevert(r *schema.Resource) {
+	if r.Create != nil {
+		r.Create = configRevertWrap(r.Create)
+	}
+	if r.Update != nil {
+		r.Update = configRevertWrap(r.Update)
+	}
+	if r.Delete != nil {
+		r.Delete = configRevertWrap(r.Delete)
+	}
+}
+
+func configRevertWrap(fn func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		f, ok := m.(*FortiClient)
+		if !ok || f == nil || f.Client == nil || f.ConfigRevertTimeout == 0 {
+			return fn(d, m)
+		}
+
+		if err := f.beginConfigRevert(); err != nil {
+			return err
+		}
+
+		err := fn(d, m)
+
+		if cerr := f.endConfigRevert(); cerr != nil {
+			if err != nil {
+				return fmt.Errorf("%v\n%v", err, cerr)
+			}
+			return cerr
+		}
+
+		return err
+	}
+}
+
+// beginConfigRevert begins a write, the FortiGate is switched to the
+// configuration revert mode before the first one
+func (f *FortiClient) beginConfigRevert() error {
+	f.configRevertLock.Lock()
+	defer f.configRevertLock.Unlock()
+
+	if f.configRevertErr != nil {
+		return f.configRevertErr
+	}
+	if f.configRevertMode != "" {
+		f.configRevertWrites++
+		return nil
+	}
+
+	c := f.Client
+
+	o, err := cmdbRead(c, "system/global", nil, "")
+	if err != nil {
+		return fmt.Errorf("Error reading the configuration save mode: %v", err)
+	}
+	mode, _ := o["cfg-save"].(string)
+	if mode == "" {
+		mode = "automatic"
+	}
+
+	obj := map[string]interface{}{
+		"cfg-save":           "revert",
+		"cfg-revert-timeout": f.ConfigRevertTimeout,
+	}
+	if _, err := cmdbCreateUpdate(c, "PUT", "system/global", nil, obj, ""); err != nil {
+		return fmt.Errorf("Error switching to the configuration revert mode: %v", err)
+	}
+
+	if err := f.saveConfig(); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] configuration revert mode enabled, unsaved changes are reverted after %d seconds", f.ConfigRevertTimeout)
+	f.configRevertMode = mode
+	f.configRevertSaved = time.Now()
+	f.configRevertWrites = 1
+
+	return nil
+}
+
+// endConfigRevert ends a write. Once the writes running concurrently
+// complete, it checks the FortiGate can still be reached, and saves the
+// configuration if half of the timeout has passed since the last save.
+func (f *FortiClient) endConfigRevert() error {
+	f.configRevertLock.Lock()
+	defer f.configRevertLock.Unlock()
+
+	f.configRevertWrites--
+	if f.configRevertWrites > 0 || f.configRevertErr != nil {
+		return nil
+	}
+
+	if err := f.probeConfig(); err != nil {
+		f.configRevertErr = fmt.Errorf("Error reaching the FortiGate after the changes, the configuration is not saved and the FortiGate will revert it by rebooting in %d seconds: %v", f.ConfigRevertTimeout, err)
+		return f.configRevertErr
+	}
+
+	if time.Since(f.configRevertSaved) < time.Duration(f.ConfigRevertTimeout)*time.Second/2 {
+		return nil
+	}
+	if err := f.saveConfig(); err != nil {
+		return err
+	}
+	f.configRevertSaved = time.Now()
+
+	return nil
+}
+
+// closeConfigRevert saves the configuration written by the provider, if the
+// FortiGate can still be reached, and restores the save mode found before the
+// first write
+func (f *FortiClient) closeConfigRevert() {
+	f.configRevertLock.Lock()
+	defer f.configRevertLock.Unlock()
+
+	mode := f.configRevertMode
+	if mode == "" || f.Client == nil {
+		return
+	}
+	f.configRevertMode = ""
+
+	if f.configRevertErr != nil {
+		log.Printf("[ERROR] %v", f.configRevertErr)
+		return
+	}
+
+	if err := f.probeConfig(); err != nil {
+		log.Printf("[ERROR] Error reaching the FortiGate after the changes, the configuration is not saved and the FortiGate will revert it by rebooting in %d seconds: %v", f.ConfigRevertTimeout, err)
+		return
+	}
+
+	if err := f.saveConfig(); err != nil {
+		log.Printf("[ERROR] %v", err)
+		return
+	}
+
+	if mode == "revert" {
+		return
+	}
+
+	obj := map[string]interface{}{
+		"cfg-save": mode,
+	}
+	if _, err := cmdbCreateUpdate(f.Client, "PUT", "system/global", nil, obj, ""); err != nil {
+		log.Printf("[ERROR] Error restoring the configuration save mode %s: %v", mode, err)
+		return
+	}
+
+	if err := f.saveConfig(); err != nil {
+		log.Printf("[ERROR] %v", err)
+	}
+}
+
+// probeConfig checks the FortiGate API can be reached through a new connection
+func (f *FortiClient) probeConfig() error {
+	c := f.Client
+
+	if c.Config.HTTPCon != nil {
+		c.Config.HTTPCon.CloseIdleConnections()
+	}
+
+	o, err := monitorRead(c, "system/status", "", "")
+	if err != nil {
+		return err
+	}
+	if o == nil {
+		return fmt.Errorf("no system status returned")
+	}
+
+	return nil
+}
+
+func (f *FortiClient) saveConfig() error {
+	_, err := cmdbSend(f.Client, "POST", "/api/v2/monitor/system/config/save", "", "", nil)
+	if err != nil {
+		return fmt.Errorf("Error saving the configuration: %v", err)
+	}
+
+	return nil
+}
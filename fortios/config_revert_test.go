@@ -0,0 +1,110 @@
+package fortios
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestConfigRevertWrap(t *testing.T) {
+	cases := []struct {
+		name   string
+		writes int
+		down   bool
+		want   []string
+	}{
+		{
+			name:   "writes",
+			writes: 3,
+			want: []string{
+				"GET system/global", "PUT system/global revert", "POST save",
+				"GET status", "GET status", "GET status",
+				"GET status", "POST save", "PUT system/global automatic", "POST save",
+			},
+		},
+		{
+			name:   "unreachable",
+			writes: 3,
+			down:   true,
+			want: []string{
+				"GET system/global", "PUT system/global revert", "POST save",
+				"GET status",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		var lock sync.Mutex
+		var calls []string
+		var down bool
+
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			result := map[string]interface{}{"status": "success", "http_status": 200, "version": "v7.0.5", "serial": "FGVM"}
+
+			var call string
+			switch {
+			case strings.HasSuffix(r.URL.Path, "/cmdb/system/global") && r.Method == "GET":
+				call = "GET system/global"
+				result["results"] = map[string]interface{}{"cfg-save": "automatic"}
+			case strings.HasSuffix(r.URL.Path, "/cmdb/system/global"):
+				var obj map[string]interface{}
+				json.NewDecoder(r.Body).Decode(&obj)
+				call = "PUT system/global " + obj["cfg-save"].(string)
+			case strings.HasSuffix(r.URL.Path, "/config/save"):
+				call = "POST save"
+			case strings.HasSuffix(r.URL.Path, "/system/status") && r.URL.Query().Get("access_token") != "":
+				lock.Lock()
+				unreachable := down
+				lock.Unlock()
+				if unreachable {
+					result["status"], result["http_status"] = "error", 500
+				}
+				call = "GET status"
+			}
+
+			lock.Lock()
+			if call != "" {
+				calls = append(calls, call)
+			}
+			lock.Unlock()
+
+			json.NewEncoder(w).Encode(result)
+		}))
+
+		insecure := true
+		m, err := (&Config{Hostname: strings.TrimPrefix(server.URL, "https://"), Token: "x", Insecure: &insecure}).CreateClient()
+		if err != nil {
+			t.Fatal(err)
+		}
+		f := m.(*FortiClient)
+		f.ConfigRevertTimeout = 600
+
+		lock.Lock()
+		calls, down = nil, c.down
+		lock.Unlock()
+
+		for i := 0; i < c.writes; i++ {
+			ran := false
+			fn := configRevertWrap(func(*schema.ResourceData, interface{}) error {
+				ran = true
+				return nil
+			})
+			err := fn(nil, f)
+			if c.down && i > 0 && (ran || err == nil) {
+				t.Errorf("%s: write %d ran = %v, returned %v, want an error without running", c.name, i, ran, err)
+			}
+		}
+		f.closeConfigRevert()
+		server.Close()
+
+		if !reflect.DeepEqual(calls, c.want) {
+			t.Errorf("%s: calls = %q, want %q", c.name, calls, c.want)
+		}
+	}
+}
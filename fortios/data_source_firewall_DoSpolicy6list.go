@@ -52,7 +52,7 @@ func dataSourceFirewallDosPolicy6ListRead(d *schema.ResourceData, m interface{})
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/DoS-policy6", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall/DoS-policy6", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallDosPolicy6: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceFirewallAddress6ListRead(d *schema.ResourceData, m interface{}) e
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/address6", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall/address6", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallAddress6: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceFirewallAddress6TemplateListRead(d *schema.ResourceData, m interf
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/address6-template", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall/address6-template", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallAddress6Template: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceFirewallAddressListRead(d *schema.ResourceData, m interface{}) er
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/address", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall/address", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallAddress: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceFirewallAddrgrp6ListRead(d *schema.ResourceData, m interface{}) e
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/addrgrp6", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall/addrgrp6", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallAddrgrp6: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceFirewallCentralSnatMapListRead(d *schema.ResourceData, m interfac
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/central-snat-map", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall/central-snat-map", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallCentralSnatMap: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceFirewallInternetServiceCustomGroupListRead(d *schema.ResourceData
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/internet-service-custom-group", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall/internet-service-custom-group", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallInternetServiceCustomGroup: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceFirewallInternetServiceCustomListRead(d *schema.ResourceData, m i
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/internet-service-custom", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall/internet-service-custom", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallInternetServiceCustom: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceFirewallInternetServiceDefinitionListRead(d *schema.ResourceData,
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/internet-service-definition", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall/internet-service-definition", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallInternetServiceDefinition: %v", err)
 	}
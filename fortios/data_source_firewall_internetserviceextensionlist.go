@@ -52,7 +52,7 @@ func dataSourceFirewallInternetServiceExtensionListRead(d *schema.ResourceData,
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/internet-service-extension", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall/internet-service-extension", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallInternetServiceExtension: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceFirewallInternetServiceGroupListRead(d *schema.ResourceData, m in
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/internet-service-group", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall/internet-service-group", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallInternetServiceGroup: %v", err)
 	}
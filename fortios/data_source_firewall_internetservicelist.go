@@ -52,7 +52,7 @@ func dataSourceFirewallInternetServiceListRead(d *schema.ResourceData, m interfa
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/internet-service", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall/internet-service", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallInternetService: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceFirewallMulticastAddress6ListRead(d *schema.ResourceData, m inter
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/multicast-address6", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall/multicast-address6", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallMulticastAddress6: %v", err)
 	}
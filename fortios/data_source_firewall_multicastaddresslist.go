@@ -52,7 +52,7 @@ func dataSourceFirewallMulticastAddressListRead(d *schema.ResourceData, m interf
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/multicast-address", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall/multicast-address", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallMulticastAddress: %v", err)
 	}
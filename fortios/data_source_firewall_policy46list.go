@@ -52,7 +52,7 @@ func dataSourceFirewallPolicy46ListRead(d *schema.ResourceData, m interface{}) e
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/policy46", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall/policy46", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallPolicy46: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceFirewallPolicy64ListRead(d *schema.ResourceData, m interface{}) e
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/policy64", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall/policy64", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallPolicy64: %v", err)
 	}
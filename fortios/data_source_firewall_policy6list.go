@@ -52,7 +52,7 @@ func dataSourceFirewallPolicy6ListRead(d *schema.ResourceData, m interface{}) er
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/policy6", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall/policy6", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallPolicy6: %v", err)
 	}
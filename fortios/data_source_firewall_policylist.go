@@ -52,7 +52,7 @@ func dataSourceFirewallPolicyListRead(d *schema.ResourceData, m interface{}) err
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/policy", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall/policy", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallPolicy: %v", err)
 	}
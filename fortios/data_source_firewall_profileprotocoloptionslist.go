@@ -52,7 +52,7 @@ func dataSourceFirewallProfileProtocolOptionsListRead(d *schema.ResourceData, m
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/profile-protocol-options", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall/profile-protocol-options", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallProfileProtocolOptions: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceFirewallProxyAddressListRead(d *schema.ResourceData, m interface{
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/proxy-address", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall/proxy-address", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallProxyAddress: %v", err)
 	}
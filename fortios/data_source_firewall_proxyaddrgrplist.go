@@ -52,7 +52,7 @@ func dataSourceFirewallProxyAddrgrpListRead(d *schema.ResourceData, m interface{
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/proxy-addrgrp", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall/proxy-addrgrp", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallProxyAddrgrp: %v", err)
 	}
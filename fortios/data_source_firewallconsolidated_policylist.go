@@ -52,7 +52,7 @@ func dataSourceFirewallConsolidatedPolicyListRead(d *schema.ResourceData, m inte
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall.consolidated/policy", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall.consolidated/policy", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallConsolidatedPolicy: %v", err)
 	}
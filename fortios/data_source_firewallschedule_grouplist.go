@@ -52,7 +52,7 @@ func dataSourceFirewallScheduleGroupListRead(d *schema.ResourceData, m interface
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall.schedule/group", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall.schedule/group", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallScheduleGroup: %v", err)
 	}
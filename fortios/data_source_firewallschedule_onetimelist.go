@@ -52,7 +52,7 @@ func dataSourceFirewallScheduleOnetimeListRead(d *schema.ResourceData, m interfa
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall.schedule/onetime", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall.schedule/onetime", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallScheduleOnetime: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceFirewallScheduleRecurringListRead(d *schema.ResourceData, m inter
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall.schedule/recurring", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall.schedule/recurring", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallScheduleRecurring: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceFirewallServiceCategoryListRead(d *schema.ResourceData, m interfa
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall.service/category", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall.service/category", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallServiceCategory: %v", err)
 	}
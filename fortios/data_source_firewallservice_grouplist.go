@@ -52,7 +52,7 @@ func dataSourceFirewallServiceGroupListRead(d *schema.ResourceData, m interface{
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall.service/group", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall.service/group", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallServiceGroup: %v", err)
 	}
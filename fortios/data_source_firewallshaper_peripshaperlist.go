@@ -52,7 +52,7 @@ func dataSourceFirewallShaperPerIpShaperListRead(d *schema.ResourceData, m inter
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall.shaper/per-ip-shaper", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall.shaper/per-ip-shaper", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallShaperPerIpShaper: %v", err)
 	}
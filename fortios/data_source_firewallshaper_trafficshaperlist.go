@@ -52,7 +52,7 @@ func dataSourceFirewallShaperTrafficShaperListRead(d *schema.ResourceData, m int
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall.shaper/traffic-shaper", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall.shaper/traffic-shaper", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallShaperTrafficShaper: %v", err)
 	}
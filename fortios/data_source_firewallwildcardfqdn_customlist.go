@@ -52,7 +52,7 @@ func dataSourceFirewallWildcardFqdnCustomListRead(d *schema.ResourceData, m inte
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall.wildcard-fqdn/custom", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall.wildcard-fqdn/custom", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallWildcardFqdnCustom: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceFirewallWildcardFqdnGroupListRead(d *schema.ResourceData, m inter
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall.wildcard-fqdn/group", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/firewall.wildcard-fqdn/group", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallWildcardFqdnGroup: %v", err)
 	}
@@ -0,0 +1,95 @@
+package fortios
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFortimanagerAdomObjects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFortimanagerAdomObjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"adom": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "root",
+			},
+			"path": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name_filter": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"names": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"objects": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attributes": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFortimanagerAdomObjectsRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("dataSourceFortimanagerAdomObjectsRead")()
+
+	adom := d.Get("adom").(string)
+	path := strings.Trim(d.Get("path").(string), "/")
+
+	var p map[string]interface{}
+	if f := d.Get("name_filter").(string); f != "" {
+		p = map[string]interface{}{
+			"filter": []interface{}{"name", "like", f},
+		}
+	}
+
+	l, err := fmgGetList(c, fmgObjectURL(adom, path, ""), p)
+	if err != nil {
+		return fmt.Errorf("Error reading the objects %s of ADOM %s: %v", path, adom, err)
+	}
+
+	names := make([]string, 0, len(l))
+	objects := make([]map[string]interface{}, 0, len(l))
+	for _, o := range l {
+		attrs, err := json.Marshal(o)
+		if err != nil {
+			return fmt.Errorf("Error reading the objects %s of ADOM %s: %v", path, adom, err)
+		}
+
+		names = append(names, fmgString(o["name"]))
+		objects = append(objects, map[string]interface{}{
+			"name":       fmgString(o["name"]),
+			"attributes": string(attrs),
+		})
+	}
+
+	d.SetId(adom + "/" + path)
+	d.Set("names", names)
+	d.Set("objects", objects)
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFortimanagerAdoms() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFortimanagerAdomsRead,
+
+		Schema: map[string]*schema.Schema{
+			"names": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"adoms": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"os_version": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFortimanagerAdomsRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("dataSourceFortimanagerAdomsRead")()
+
+	l, err := fmgGetList(c, "/dvmdb/adom", nil)
+	if err != nil {
+		return fmt.Errorf("Error reading the ADOMs: %v", err)
+	}
+
+	names := make([]string, 0, len(l))
+	adoms := make([]map[string]interface{}, 0, len(l))
+	for _, o := range l {
+		version := fmgString(o["os_ver"])
+		if mr := fmgString(o["mr"]); mr != "" {
+			version += "." + mr
+		}
+
+		names = append(names, fmgString(o["name"]))
+		adoms = append(adoms, map[string]interface{}{
+			"name":        fmgString(o["name"]),
+			"description": fmgString(o["desc"]),
+			"os_version":  version,
+			"state":       fmgString(o["state"]),
+		})
+	}
+
+	d.SetId("adoms")
+	d.Set("names", names)
+	d.Set("adoms", adoms)
+
+	return nil
+}
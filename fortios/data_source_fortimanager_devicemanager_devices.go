@@ -0,0 +1,147 @@
+package fortios
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFortimanagerDVMDevices() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFortimanagerDVMDevicesRead,
+
+		Schema: map[string]*schema.Schema{
+			"adom": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "root",
+			},
+			"names": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"devices": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"serial": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"platform": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"os_version": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"build": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ha_mode": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"conn_status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"conf_status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"db_status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dev_status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vdoms": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFortimanagerDVMDevicesRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("dataSourceFortimanagerDVMDevicesRead")()
+
+	adom := d.Get("adom").(string)
+
+	l, err := fmgGetList(c, "/dvmdb/adom/"+adom+"/device", nil)
+	if err != nil {
+		return fmt.Errorf("Error reading the devices of ADOM %s: %v", adom, err)
+	}
+
+	sort.Slice(l, func(i, j int) bool {
+		return fmgString(l[i]["name"]) < fmgString(l[j]["name"])
+	})
+
+	names := make([]string, 0, len(l))
+	devices := make([]map[string]interface{}, 0, len(l))
+	for _, o := range l {
+		vdoms := []string{}
+		if v, ok := o["vdom"].([]interface{}); ok {
+			for _, i := range v {
+				if vdom, ok := i.(map[string]interface{}); ok {
+					vdoms = append(vdoms, fmgString(vdom["name"]))
+				}
+			}
+		}
+
+		version := fmgString(o["os_ver"])
+		if mr := fmgString(o["mr"]); mr != "" {
+			version += "." + mr
+		}
+		if patch := fmgString(o["patch"]); patch != "" {
+			version += "." + patch
+		}
+
+		names = append(names, fmgString(o["name"]))
+		devices = append(devices, map[string]interface{}{
+			"name":        fmgString(o["name"]),
+			"serial":      fmgString(o["sn"]),
+			"ip":          fmgString(o["ip"]),
+			"platform":    fmgString(o["platform_str"]),
+			"os_version":  version,
+			"build":       fmgString(o["build"]),
+			"ha_mode":     fmgString(o["ha_mode"]),
+			"conn_status": fmgString(o["conn_status"]),
+			"conf_status": fmgString(o["conf_status"]),
+			"db_status":   fmgString(o["db_status"]),
+			"dev_status":  fmgString(o["dev_status"]),
+			"vdoms":       vdoms,
+		})
+	}
+
+	d.SetId(adom)
+	d.Set("names", names)
+	d.Set("devices", devices)
+
+	return nil
+}
@@ -0,0 +1,205 @@
+package fortios
+
+import (
+	"fmt"
+	"log"
+
+	fmgclient "github.com/fortinetdev/forti-sdk-go/fortimanager/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFortimanagerDVMInstallPreview() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFortimanagerDVMInstallPreviewRead,
+
+		Schema: map[string]*schema.Schema{
+			"package_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"adom": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "root",
+			},
+			"timeout": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     3,
+				Description: "Timeout for generating the preview, default: 3 minutes",
+			},
+			"scope": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"vdom": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "root",
+						},
+					},
+				},
+			},
+			"preview": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vdom": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cli": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFortimanagerDVMInstallPreviewRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("dataSourceFortimanagerDVMInstallPreviewRead")()
+
+	adom := d.Get("adom").(string)
+	pkg := d.Get("package_name").(string)
+	timeout := d.Get("timeout").(int)
+
+	scope := []map[string]interface{}{}
+	for _, v := range d.Get("scope").([]interface{}) {
+		if i, ok := v.(map[string]interface{}); ok {
+			scope = append(scope, map[string]interface{}{
+				"name": i["name"],
+				"vdom": i["vdom"],
+			})
+		}
+	}
+
+	if len(scope) == 0 {
+		var err error
+		if scope, err = fmgPackageScope(c, adom, pkg); err != nil {
+			return fmt.Errorf("Error reading the installation targets of policy package %s: %v", pkg, err)
+		}
+		if len(scope) == 0 {
+			return fmt.Errorf("Error previewing policy package %s: it has no installation target, set scope", pkg)
+		}
+	}
+
+	// the package is installed in preview mode first, FortiManager then
+	// generates the CLI of each device
+	task, err := fmgStartTask(c, "/securityconsole/install/package", map[string]interface{}{
+		"adom":  adom,
+		"pkg":   pkg,
+		"scope": scope,
+		"flags": []string{"preview"},
+	})
+	if err != nil {
+		return fmt.Errorf("Error previewing policy package %s: %v", pkg, err)
+	}
+
+	// the preview installation is canceled whatever happens once its task is
+	// started, including when it fails or times out
+	defer func() {
+		_, err := fmgCall(c, "exec", map[string]interface{}{
+			"url":  "/securityconsole/package/cancel/install",
+			"data": map[string]interface{}{"adom": adom},
+		})
+		if err != nil {
+			log.Printf("[WARN] cannot cancel the preview of policy package %s: %v", pkg, err)
+		}
+	}()
+
+	if err := c.QueryTask(task, timeout); err != nil {
+		return fmt.Errorf("Error previewing policy package %s: %v", pkg, err)
+	}
+
+	err = fmgRunTask(c, timeout, "/securityconsole/install/preview", map[string]interface{}{
+		"adom":   adom,
+		"device": scope,
+		"flags":  []string{"none"},
+	})
+	if err != nil {
+		return fmt.Errorf("Error previewing policy package %s: %v", pkg, err)
+	}
+
+	preview := make([]map[string]interface{}, 0, len(scope))
+	for _, dev := range scope {
+		result, err := fmgCall(c, "exec", map[string]interface{}{
+			"url": "/securityconsole/preview/result",
+			"data": map[string]interface{}{
+				"adom":   adom,
+				"device": []map[string]interface{}{dev},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("Error reading the preview of policy package %s for %v: %v", pkg, dev["name"], err)
+		}
+
+		data, _ := result[0]["data"].(map[string]interface{})
+		cli, _ := data["message"].(string)
+
+		preview = append(preview, map[string]interface{}{
+			"name": dev["name"],
+			"vdom": dev["vdom"],
+			"cli":  cli,
+		})
+	}
+
+	d.SetId(adom + "/" + pkg)
+	d.Set("scope", scope)
+	d.Set("preview", preview)
+
+	return nil
+}
+
+// fmgPackageScope returns the installation targets of the policy package pkg
+// of the ADOM adom
+func fmgPackageScope(c *fmgclient.FmgSDKClient, adom, pkg string) ([]map[string]interface{}, error) {
+	result, err := fmgCall(c, "get", map[string]interface{}{
+		"url": "/pm/pkg/adom/" + adom + "/" + pkg,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data, _ := result[0]["data"].(map[string]interface{})
+
+	var members []interface{}
+	switch v := data["scope member"].(type) {
+	case []interface{}:
+		members = v
+	case map[string]interface{}:
+		members = []interface{}{v}
+	}
+
+	scope := []map[string]interface{}{}
+	for _, v := range members {
+		i, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		vdom, _ := i["vdom"].(string)
+		if vdom == "" {
+			vdom = "root"
+		}
+		scope = append(scope, map[string]interface{}{
+			"name": i["name"],
+			"vdom": vdom,
+		})
+	}
+
+	return scope, nil
+}
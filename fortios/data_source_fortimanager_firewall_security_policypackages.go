@@ -0,0 +1,133 @@
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFortimanagerFirewallSecurityPolicyPackages() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFortimanagerFirewallSecurityPolicyPackagesRead,
+
+		Schema: map[string]*schema.Schema{
+			"adom": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "root",
+			},
+			"names": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"packages": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"inspection_mode": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scope": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"vdom": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFortimanagerFirewallSecurityPolicyPackagesRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("dataSourceFortimanagerFirewallSecurityPolicyPackagesRead")()
+
+	adom := d.Get("adom").(string)
+
+	l, err := fmgGetList(c, "/pm/pkg/adom/"+adom, nil)
+	if err != nil {
+		return fmt.Errorf("Error reading the policy packages of ADOM %s: %v", adom, err)
+	}
+
+	names := []string{}
+	packages := []map[string]interface{}{}
+
+	// the packages can be grouped in folders, they are named as
+	// "folder/package"
+	var walk func(l []interface{}, prefix string)
+	walk = func(l []interface{}, prefix string) {
+		for _, i := range l {
+			o, ok := i.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			name := prefix + fmgString(o["name"])
+			if fmgString(o["type"]) == "folder" {
+				sub, _ := o["subobj"].([]interface{})
+				walk(sub, name+"/")
+				continue
+			}
+
+			var members []interface{}
+			switch v := o["scope member"].(type) {
+			case []interface{}:
+				members = v
+			case map[string]interface{}:
+				members = []interface{}{v}
+			}
+
+			scope := []map[string]interface{}{}
+			for _, i := range members {
+				if s, ok := i.(map[string]interface{}); ok {
+					scope = append(scope, map[string]interface{}{
+						"name": fmgString(s["name"]),
+						"vdom": fmgString(s["vdom"]),
+					})
+				}
+			}
+
+			settings, _ := o["package settings"].(map[string]interface{})
+
+			names = append(names, name)
+			packages = append(packages, map[string]interface{}{
+				"name":            name,
+				"inspection_mode": fmgString(settings["inspection-mode"]),
+				"scope":           scope,
+			})
+		}
+	}
+
+	top := make([]interface{}, 0, len(l))
+	for _, o := range l {
+		top = append(top, o)
+	}
+	walk(top, "")
+
+	d.SetId(adom)
+	d.Set("names", names)
+	d.Set("packages", packages)
+
+	return nil
+}
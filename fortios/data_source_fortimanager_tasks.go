@@ -0,0 +1,137 @@
+package fortios
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceFortimanagerTasks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFortimanagerTasksRead,
+
+		Schema: map[string]*schema.Schema{
+			"title_filter": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"limit": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      20,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"tasks": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"title": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"src": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"percent": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"num_done": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"num_err": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"num_lines": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"start_tm": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"end_tm": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFortimanagerTasksRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("dataSourceFortimanagerTasksRead")()
+
+	limit := d.Get("limit").(int)
+
+	// only the most recent tasks are read
+	p := map[string]interface{}{
+		"sortings": []interface{}{map[string]interface{}{"id": -1}},
+		"range":    []interface{}{0, limit},
+	}
+	if f := d.Get("title_filter").(string); f != "" {
+		p["filter"] = []interface{}{"title", "like", f}
+	}
+
+	l, err := fmgGetList(c, "/task/task", p)
+	if err != nil {
+		return fmt.Errorf("Error reading the tasks: %v", err)
+	}
+
+	toInt := func(v interface{}) int {
+		i, _ := fmgObjectValue(0, v).(int)
+		return i
+	}
+
+	// not all the versions of FortiManager sort the tasks
+	sort.Slice(l, func(i, j int) bool {
+		return toInt(l[i]["id"]) > toInt(l[j]["id"])
+	})
+	if len(l) > limit {
+		l = l[:limit]
+	}
+
+	tasks := make([]map[string]interface{}, 0, len(l))
+	for _, o := range l {
+		tasks = append(tasks, map[string]interface{}{
+			"id":        toInt(o["id"]),
+			"title":     fmgString(o["title"]),
+			"src":       fmgString(o["src"]),
+			"user":      fmgString(o["user"]),
+			"state":     fmgString(o["state"]),
+			"percent":   toInt(o["percent"]),
+			"num_done":  toInt(o["num_done"]),
+			"num_err":   toInt(o["num_err"]),
+			"num_lines": toInt(o["num_lines"]),
+			"start_tm":  toInt(o["start_tm"]),
+			"end_tm":    toInt(o["end_tm"]),
+		})
+	}
+
+	d.SetId("tasks/" + d.Get("title_filter").(string) + "/" + strconv.Itoa(limit))
+	d.Set("tasks", tasks)
+
+	return nil
+}
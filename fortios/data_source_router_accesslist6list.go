@@ -52,7 +52,7 @@ func dataSourceRouterAccessList6ListRead(d *schema.ResourceData, m interface{})
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/access-list6", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/router/access-list6", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterAccessList6: %v", err)
 	}
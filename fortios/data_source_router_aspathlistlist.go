@@ -52,7 +52,7 @@ func dataSourceRouterAspathListListRead(d *schema.ResourceData, m interface{}) e
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/aspath-list", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/router/aspath-list", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterAspathList: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceRouterAuthPathListRead(d *schema.ResourceData, m interface{}) err
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/auth-path", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/router/auth-path", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterAuthPath: %v", err)
 	}
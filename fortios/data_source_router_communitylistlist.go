@@ -52,7 +52,7 @@ func dataSourceRouterCommunityListListRead(d *schema.ResourceData, m interface{}
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/community-list", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/router/community-list", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterCommunityList: %v", err)
 	}
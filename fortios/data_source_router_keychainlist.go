@@ -52,7 +52,7 @@ func dataSourceRouterKeyChainListRead(d *schema.ResourceData, m interface{}) err
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/key-chain", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/router/key-chain", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterKeyChain: %v", err)
 	}
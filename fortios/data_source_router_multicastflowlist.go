@@ -52,7 +52,7 @@ func dataSourceRouterMulticastFlowListRead(d *schema.ResourceData, m interface{}
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/multicast-flow", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/router/multicast-flow", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterMulticastFlow: %v", err)
 	}
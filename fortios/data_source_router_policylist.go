@@ -52,7 +52,7 @@ func dataSourceRouterPolicyListRead(d *schema.ResourceData, m interface{}) error
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/policy", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/router/policy", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterPolicy: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceRouterPrefixList6ListRead(d *schema.ResourceData, m interface{})
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/prefix-list6", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/router/prefix-list6", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterPrefixList6: %v", err)
 	}
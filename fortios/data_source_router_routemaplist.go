@@ -52,7 +52,7 @@ func dataSourceRouterRouteMapListRead(d *schema.ResourceData, m interface{}) err
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/route-map", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/router/route-map", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterRouteMap: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceRouterStatic6ListRead(d *schema.ResourceData, m interface{}) erro
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/static6", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/router/static6", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterStatic6: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceRouterStaticListRead(d *schema.ResourceData, m interface{}) error
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/static", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/router/static", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterStatic: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceRouterbgpNeighborListRead(d *schema.ResourceData, m interface{})
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/bgp/neighbor", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/router/bgp/neighbor", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterbgpNeighbor: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceSystemAccprofileListRead(d *schema.ResourceData, m interface{}) e
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/accprofile", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/accprofile", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemAccprofile: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceSystemAdminListRead(d *schema.ResourceData, m interface{}) error
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/admin", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/admin", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemAdmin: %v", err)
 	}
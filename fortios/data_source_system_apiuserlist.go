@@ -52,7 +52,7 @@ func dataSourceSystemApiUserListRead(d *schema.ResourceData, m interface{}) erro
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/api-user", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/api-user", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemApiUser: %v", err)
 	}
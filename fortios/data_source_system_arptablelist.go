@@ -52,7 +52,7 @@ func dataSourceSystemArpTableListRead(d *schema.ResourceData, m interface{}) err
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/arp-table", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/arp-table", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemArpTable: %v", err)
 	}
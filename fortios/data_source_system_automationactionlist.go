@@ -52,7 +52,7 @@ func dataSourceSystemAutomationActionListRead(d *schema.ResourceData, m interfac
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/automation-action", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/automation-action", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemAutomationAction: %v", err)
 	}
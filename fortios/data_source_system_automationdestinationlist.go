@@ -52,7 +52,7 @@ func dataSourceSystemAutomationDestinationListRead(d *schema.ResourceData, m int
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/automation-destination", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/automation-destination", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemAutomationDestination: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceSystemAutomationTriggerListRead(d *schema.ResourceData, m interfa
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/automation-trigger", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/automation-trigger", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemAutomationTrigger: %v", err)
 	}
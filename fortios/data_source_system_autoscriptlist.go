@@ -52,7 +52,7 @@ func dataSourceSystemAutoScriptListRead(d *schema.ResourceData, m interface{}) e
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/auto-script", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/auto-script", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemAutoScript: %v", err)
 	}
@@ -0,0 +1,216 @@
+package fortios
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSystemCapabilities() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSystemCapabilitiesRead,
+		Schema: map[string]*schema.Schema{
+			"vdomparam": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"version": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"build": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"serial": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hostname": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"model": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"model_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"model_number": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vdom_mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ha_mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"consolidated_policy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"policy_resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"license_features": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"cmdb_tables": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSystemCapabilitiesRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	status, err := monitorRead(c, "system/status", "", vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing SystemCapabilities: %v", err)
+	}
+	if status == nil {
+		return fmt.Errorf("Error describing SystemCapabilities: no system status returned")
+	}
+
+	version := c.Fv
+	if v, ok := status["version"].(string); ok && v != "" {
+		version = v
+	}
+	d.Set("version", version)
+	if v, ok := status["build"].(float64); ok {
+		d.Set("build", int(v))
+	}
+	if v, ok := status["serial"].(string); ok {
+		d.Set("serial", v)
+	}
+	if r, ok := status["results"].(map[string]interface{}); ok {
+		for k, v := range map[string]string{
+			"hostname":     "hostname",
+			"model":        "model",
+			"model_name":   "model_name",
+			"model_number": "model_number",
+		} {
+			if s, ok := r[k].(string); ok {
+				d.Set(v, s)
+			}
+		}
+	}
+
+	global, err := cmdbRead(c, "system/global", nil, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing SystemCapabilities: %v", err)
+	}
+	d.Set("vdom_mode", dataSourceSystemCapabilitiesVdomMode(global))
+
+	ha, err := cmdbRead(c, "system/ha", nil, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing SystemCapabilities: %v", err)
+	}
+	if v, ok := ha["mode"].(string); ok {
+		d.Set("ha_mode", v)
+	}
+
+	consolidated := false
+	settings, err := cmdbRead(c, "system/settings", nil, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing SystemCapabilities: %v", err)
+	}
+	if v, ok := settings["consolidated-firewall-mode"].(string); ok {
+		consolidated = v == "enable"
+	}
+	d.Set("consolidated_policy", consolidated)
+	if consolidated {
+		d.Set("policy_resource", "fortios_firewallconsolidated_policy")
+	} else {
+		d.Set("policy_resource", "fortios_firewall_policy")
+	}
+
+	features := make(map[string]interface{})
+	license, err := monitorRead(c, "license/status", "", vdomparam)
+	if err != nil {
+		log.Printf("[WARN] cannot read the license status: %v", err)
+	} else if r, ok := license["results"].(map[string]interface{}); ok {
+		for k, v := range r {
+			if o, ok := v.(map[string]interface{}); ok {
+				if s, ok := o["status"].(string); ok {
+					features[k] = s
+				}
+			}
+		}
+	}
+	d.Set("license_features", features)
+
+	tables := []string{}
+	schemas, err := cmdbSend(c, "GET", "/api/v2/cmdb/", "action=schema", vdomparam, nil)
+	if err != nil {
+		log.Printf("[WARN] cannot read the CMDB schema: %v", err)
+	} else if r, ok := schemas["results"].([]interface{}); ok {
+		for _, v := range r {
+			o, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			path, _ := o["path"].(string)
+			name, _ := o["name"].(string)
+			if path != "" && name != "" {
+				tables = append(tables, path+"/"+name)
+			}
+		}
+		sort.Strings(tables)
+	}
+	d.Set("cmdb_tables", tables)
+
+	id := "SystemCapabilities"
+	if v, ok := status["serial"].(string); ok && v != "" {
+		id = v
+	}
+	d.SetId(id)
+
+	return nil
+}
+
+// dataSourceSystemCapabilitiesVdomMode returns the VDOM mode from system
+// global, FortiOS before 6.2 only tells whether VDOMs are enabled
+func dataSourceSystemCapabilitiesVdomMode(global map[string]interface{}) string {
+	if v, ok := global["vdom-mode"].(string); ok && v != "" {
+		return v
+	}
+
+	if v, ok := global["vdom-admin"].(string); ok {
+		if strings.EqualFold(v, "enable") {
+			return "multi-vdom"
+		}
+		return "no-vdom"
+	}
+
+	return ""
+}
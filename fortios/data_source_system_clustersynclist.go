@@ -52,7 +52,7 @@ func dataSourceSystemClusterSyncListRead(d *schema.ResourceData, m interface{})
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/cluster-sync", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/cluster-sync", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemClusterSync: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceSystemDnsDatabaseListRead(d *schema.ResourceData, m interface{})
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/dns-database", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/dns-database", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemDnsDatabase: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceSystemDnsServerListRead(d *schema.ResourceData, m interface{}) er
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/dns-server", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/dns-server", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemDnsServer: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceSystemDscpBasedPriorityListRead(d *schema.ResourceData, m interfa
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/dscp-based-priority", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/dscp-based-priority", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemDscpBasedPriority: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceSystemExternalResourceListRead(d *schema.ResourceData, m interfac
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/external-resource", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/external-resource", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemExternalResource: %v", err)
 	}
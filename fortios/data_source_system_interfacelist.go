@@ -52,7 +52,7 @@ func dataSourceSystemInterfaceListRead(d *schema.ResourceData, m interface{}) er
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/interface", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/interface", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemInterface: %v", err)
 	}
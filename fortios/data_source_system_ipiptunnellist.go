@@ -52,7 +52,7 @@ func dataSourceSystemIpipTunnelListRead(d *schema.ResourceData, m interface{}) e
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/ipip-tunnel", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/ipip-tunnel", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemIpipTunnel: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceSystemIpv6NeighborCacheListRead(d *schema.ResourceData, m interfa
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/ipv6-neighbor-cache", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/ipv6-neighbor-cache", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemIpv6NeighborCache: %v", err)
 	}
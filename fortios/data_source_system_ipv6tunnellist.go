@@ -52,7 +52,7 @@ func dataSourceSystemIpv6TunnelListRead(d *schema.ResourceData, m interface{}) e
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/ipv6-tunnel", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/ipv6-tunnel", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemIpv6Tunnel: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceSystemLinkMonitorListRead(d *schema.ResourceData, m interface{})
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/link-monitor", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/link-monitor", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemLinkMonitor: %v", err)
 	}
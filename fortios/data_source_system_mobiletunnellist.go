@@ -52,7 +52,7 @@ func dataSourceSystemMobileTunnelListRead(d *schema.ResourceData, m interface{})
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/mobile-tunnel", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/mobile-tunnel", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemMobileTunnel: %v", err)
 	}
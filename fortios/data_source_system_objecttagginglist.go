@@ -52,7 +52,7 @@ func dataSourceSystemObjectTaggingListRead(d *schema.ResourceData, m interface{}
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/object-tagging", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/object-tagging", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemObjectTagging: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceSystemPppoeInterfaceListRead(d *schema.ResourceData, m interface{
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/pppoe-interface", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/pppoe-interface", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemPppoeInterface: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceSystemProxyArpListRead(d *schema.ResourceData, m interface{}) err
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/proxy-arp", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/proxy-arp", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemProxyArp: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceSystemReplacemsgImageListRead(d *schema.ResourceData, m interface
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/replacemsg-image", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/replacemsg-image", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemReplacemsgImage: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceSystemSdnConnectorListRead(d *schema.ResourceData, m interface{})
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/sdn-connector", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/sdn-connector", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemSdnConnector: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceSystemSessionHelperListRead(d *schema.ResourceData, m interface{}
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/session-helper", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/session-helper", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemSessionHelper: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceSystemTosBasedPriorityListRead(d *schema.ResourceData, m interfac
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/tos-based-priority", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/tos-based-priority", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemTosBasedPriority: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceSystemVdomExceptionListRead(d *schema.ResourceData, m interface{}
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/vdom-exception", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/vdom-exception", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemVdomException: %v", err)
 	}
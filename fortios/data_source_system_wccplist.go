@@ -52,7 +52,7 @@ func dataSourceSystemWccpListRead(d *schema.ResourceData, m interface{}) error {
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/wccp", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system/wccp", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemWccp: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceSystemDhcpServerListRead(d *schema.ResourceData, m interface{}) e
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system.dhcp/server", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system.dhcp/server", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemDhcpServer: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceSystemLldpNetworkPolicyListRead(d *schema.ResourceData, m interfa
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system.lldp/network-policy", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system.lldp/network-policy", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemLldpNetworkPolicy: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceSystemSnmpCommunityListRead(d *schema.ResourceData, m interface{}
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system.snmp/community", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system.snmp/community", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemSnmpCommunity: %v", err)
 	}
@@ -52,7 +52,7 @@ func dataSourceSystemSnmpUserListRead(d *schema.ResourceData, m interface{}) err
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system.snmp/user", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/system.snmp/user", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemSnmpUser: %v", err)
 	}
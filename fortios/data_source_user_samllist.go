@@ -52,7 +52,7 @@ func dataSourceUserSamlListRead(d *schema.ResourceData, m interface{}) error {
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/user/saml", filter, vdomparam)
+	o, err := m.(*FortiClient).GenericGroupRead("/api/v2/cmdb/user/saml", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing UserSaml: %v", err)
 	}
@@ -0,0 +1,335 @@
+package fortios
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	fmgclient "github.com/fortinetdev/forti-sdk-go/fortimanager/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fmgObject describes an object of the ADOM database of FortiManager, stored
+// under /pm/config/adom/<adom>/obj/<path>. The arguments of the resource are
+// the keys of attrs, and the attributes of the object their values.
+type fmgObject struct {
+	path  string
+	attrs map[string]string
+}
+
+// fmgObjectNotFound is the status code of FortiManager for the objects that
+// don't exist
+const fmgObjectNotFound = -3
+
+func fmgObjectURL(adom, path, name string) string {
+	u := "/pm/config/adom/" + adom + "/obj/" + path
+	if name != "" {
+		u += "/" + name
+	}
+
+	return u
+}
+
+// fmgStatusCode returns the status code of the result r of a JSON-RPC call
+func fmgStatusCode(r map[string]interface{}) int {
+	st, _ := r["status"].(map[string]interface{})
+	code, _ := st["code"].(float64)
+
+	return int(code)
+}
+
+// fmgReadObject reads the object name of the table path of the ADOM adom, it
+// returns nil if the object doesn't exist. The enumerations are read as their
+// names instead of their numbers.
+func fmgReadObject(c *fmgclient.FmgSDKClient, adom, path, name string) (map[string]interface{}, error) {
+	return fmgGet(c, fmgObjectURL(adom, path, name))
+}
+
+// fmgGet reads the object url, it returns nil if the object doesn't exist
+func fmgGet(c *fmgclient.FmgSDKClient, url string) (map[string]interface{}, error) {
+	result, err := fmgCall(c, "get", map[string]interface{}{
+		"url":     url,
+		"verbose": 1,
+	})
+	if err != nil {
+		if len(result) != 0 && fmgStatusCode(result[0]) == fmgObjectNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	data, _ := result[0]["data"].(map[string]interface{})
+	if data == nil {
+		return nil, fmt.Errorf("cannot get the results from the response")
+	}
+
+	return data, nil
+}
+
+// fmgFilterAttributes returns the attributes of the object got that are set in
+// want, the references of a single object read as lists being compared with
+// the strings of want
+func fmgFilterAttributes(want, got map[string]interface{}) interface{} {
+	res := make(map[string]interface{})
+	for k, w := range want {
+		g, ok := got[k]
+		if !ok {
+			continue
+		}
+		if l, ok := g.([]interface{}); ok && len(l) == 1 {
+			if _, ok := w.(string); ok {
+				g = l[0]
+			}
+		}
+		res[k] = g
+	}
+
+	return cmdbFilterAttributes(want, res)
+}
+
+// fmgObjectData returns the attributes of the object o configured in d, the
+// arguments unset are left out unless they are removed from the configuration
+func fmgObjectData(d *schema.ResourceData, o fmgObject) map[string]interface{} {
+	data := make(map[string]interface{})
+
+	for k, a := range o.attrs {
+		v, ok := d.GetOk(k)
+		if !ok && !d.HasChange(k) {
+			continue
+		}
+
+		if l, ok := v.([]interface{}); ok && l == nil {
+			v = []interface{}{}
+		}
+		data[a] = v
+	}
+
+	return data
+}
+
+// fmgObjectValue converts the attribute v of an object to the type of the
+// argument t, FortiManager returning the references as lists and the
+// lists of a single item as strings
+func fmgObjectValue(t, v interface{}) interface{} {
+	switch t.(type) {
+	case string:
+		switch v := v.(type) {
+		case string:
+			return v
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		case []interface{}:
+			l := make([]string, 0, len(v))
+			for _, i := range v {
+				l = append(l, fmt.Sprintf("%v", i))
+			}
+			return strings.Join(l, " ")
+		}
+	case int:
+		switch v := v.(type) {
+		case float64:
+			return int(v)
+		case string:
+			i, _ := strconv.Atoi(v)
+			return i
+		}
+	case []interface{}:
+		switch v := v.(type) {
+		case []interface{}:
+			return v
+		case string:
+			return []interface{}{v}
+		}
+	}
+
+	return nil
+}
+
+// fmgString returns the attribute v of an object as a string
+func fmgString(v interface{}) string {
+	s, _ := fmgObjectValue("", v).(string)
+	return s
+}
+
+// fmgGetList reads the objects of the table url matching the params p, such
+// as a filter
+func fmgGetList(c *fmgclient.FmgSDKClient, url string, p map[string]interface{}) ([]map[string]interface{}, error) {
+	params := map[string]interface{}{
+		"url":     url,
+		"verbose": 1,
+	}
+	for k, v := range p {
+		params[k] = v
+	}
+
+	result, err := fmgCall(c, "get", params)
+	if err != nil {
+		if len(result) != 0 && fmgStatusCode(result[0]) == fmgObjectNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	l, _ := result[0]["data"].([]interface{})
+
+	objects := make([]map[string]interface{}, 0, len(l))
+	for _, i := range l {
+		if o, ok := i.(map[string]interface{}); ok {
+			objects = append(objects, o)
+		}
+	}
+
+	return objects, nil
+}
+
+// fmgScopeMembers returns the devices and device groups of the scope
+// attribute k of the object data, FortiManager returning a single member as an
+// object instead of a list
+func fmgScopeMembers(data map[string]interface{}, k string) []map[string]interface{} {
+	var l []interface{}
+	switch v := data[k].(type) {
+	case []interface{}:
+		l = v
+	case map[string]interface{}:
+		l = []interface{}{v}
+	}
+
+	members := make([]map[string]interface{}, 0, len(l))
+	for _, i := range l {
+		if o, ok := i.(map[string]interface{}); ok {
+			members = append(members, o)
+		}
+	}
+
+	return members
+}
+
+// fmgSetObject sets the arguments of d to the attributes of the object data
+func fmgSetObject(d *schema.ResourceData, o fmgObject, data map[string]interface{}) error {
+	for k, a := range o.attrs {
+		v, ok := data[a]
+		if !ok {
+			continue
+		}
+
+		if err := d.Set(k, fmgObjectValue(d.Get(k), v)); err != nil {
+			return fmt.Errorf("Error reading %s: %v", k, err)
+		}
+	}
+
+	return nil
+}
+
+func createFMGObject(d *schema.ResourceData, m interface{}, o fmgObject) error {
+	c := m.(*FortiClient).ClientFortimanager
+	adom := d.Get("adom").(string)
+
+	_, err := fmgCall(c, "add", map[string]interface{}{
+		"url":  fmgObjectURL(adom, o.path, ""),
+		"data": fmgObjectData(d, o),
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return nil
+}
+
+func readFMGObject(d *schema.ResourceData, m interface{}, o fmgObject) error {
+	c := m.(*FortiClient).ClientFortimanager
+
+	data, err := fmgReadObject(c, d.Get("adom").(string), o.path, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if data == nil {
+		log.Printf("[WARN] resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return fmgSetObject(d, o, data)
+}
+
+func updateFMGObject(d *schema.ResourceData, m interface{}, o fmgObject) error {
+	c := m.(*FortiClient).ClientFortimanager
+	adom := d.Get("adom").(string)
+
+	_, err := fmgCall(c, "update", map[string]interface{}{
+		"url":  fmgObjectURL(adom, o.path, d.Id()),
+		"data": fmgObjectData(d, o),
+	})
+
+	return err
+}
+
+func deleteFMGObject(d *schema.ResourceData, m interface{}, o fmgObject) error {
+	c := m.(*FortiClient).ClientFortimanager
+	adom := d.Get("adom").(string)
+
+	result, err := fmgCall(c, "delete", map[string]interface{}{
+		"url": fmgObjectURL(adom, o.path, d.Id()),
+	})
+	if err != nil && (len(result) == 0 || fmgStatusCode(result[0]) != fmgObjectNotFound) {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// fmgObjectImportState returns the importer of the objects o, the import ID
+// is the name of the object, optionally prefixed with its ADOM as
+// "adom:name". The names can contain colons, so the ID is first read as a
+// plain name. The object must exist.
+func fmgObjectImportState(o fmgObject) schema.StateFunc {
+	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		c := m.(*FortiClient).ClientFortimanager
+		id := d.Id()
+
+		type candidate struct {
+			adom string
+			name string
+		}
+
+		adom, _ := d.Get("adom").(string)
+		if adom == "" {
+			adom = "root"
+		}
+		l := []candidate{{adom, id}}
+
+		// the ADOM names can't contain slashes
+		if i := strings.Index(id, ":"); i > 0 && !strings.Contains(id[:i], "/") {
+			l = append(l, candidate{id[:i], id[i+1:]})
+		}
+
+		var lastErr error
+
+		for _, ic := range l {
+			data, err := fmgReadObject(c, ic.adom, o.path, ic.name)
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			if data == nil {
+				continue
+			}
+
+			d.SetId(ic.name)
+			d.Set("adom", ic.adom)
+
+			return []*schema.ResourceData{d}, nil
+		}
+
+		if lastErr != nil {
+			return nil, fmt.Errorf("Error importing %s from %s: %v", id, o.path, lastErr)
+		}
+
+		return nil, fmt.Errorf("Error importing %s: object not found in %s, the import ID should be name or adom:name", id, o.path)
+	}
+}
@@ -0,0 +1,81 @@
+package fortios
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	fmgclient "github.com/fortinetdev/forti-sdk-go/fortimanager/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFMGObjectImportState(t *testing.T) {
+	objects := map[string]bool{
+		"/pm/config/adom/root/obj/firewall/addrgrp/grp0":      true,
+		"/pm/config/adom/root/obj/firewall/addrgrp/a:b":       true,
+		"/pm/config/adom/adom1/obj/firewall/addrgrp/grp1":     true,
+		"/pm/config/adom/adom1/obj/antivirus/profile/default": true,
+	}
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Params []map[string]interface{} `json:"params"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		url, _ := req.Params[0]["url"].(string)
+
+		result := map[string]interface{}{
+			"status": map[string]interface{}{"code": fmgObjectNotFound, "message": "Object does not exist"},
+		}
+		if objects[url] {
+			result = map[string]interface{}{
+				"status": map[string]interface{}{"code": 0, "message": "OK"},
+				"data":   map[string]interface{}{"name": url[strings.LastIndex(url, "/")+1:]},
+			}
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":     1,
+			"result": []interface{}{result},
+		})
+	}))
+	defer server.Close()
+
+	fc := fmgclient.NewClient(strings.TrimPrefix(server.URL, "https://"), "", "", server.Client())
+	fc.Init = true
+	f := &FortiClient{ClientFortimanager: fc}
+
+	cases := []struct {
+		resource *schema.Resource
+		id       string
+		wantID   string
+		wantAdom string
+		wantErr  bool
+	}{
+		{resource: resourceFortimanagerFirewallObjectAddressGroup(), id: "grp0", wantID: "grp0", wantAdom: "root"},
+		{resource: resourceFortimanagerFirewallObjectAddressGroup(), id: "a:b", wantID: "a:b", wantAdom: "root"},
+		{resource: resourceFortimanagerFirewallObjectAddressGroup(), id: "adom1:grp1", wantID: "grp1", wantAdom: "adom1"},
+		{resource: resourceFortimanagerFirewallObjectAddressGroup(), id: "grp1", wantErr: true},
+		{resource: resourceFortimanagerFirewallObjectAddressGroup(), id: "adom1/grp1", wantErr: true},
+		{resource: resourceFortimanagerSecurityProfile(), id: "adom1:antivirus/default", wantID: "default", wantAdom: "adom1"},
+		{resource: resourceFortimanagerSecurityProfile(), id: "adom1:antivirus/", wantErr: true},
+	}
+
+	for _, c := range cases {
+		d := c.resource.Data(nil)
+		d.SetId(c.id)
+
+		_, err := c.resource.Importer.State(d, f)
+
+		switch {
+		case c.wantErr && err == nil:
+			t.Errorf("%s: import = %s in ADOM %v, want an error", c.id, d.Id(), d.Get("adom"))
+		case !c.wantErr && err != nil:
+			t.Errorf("%s: import = %v, want no error", c.id, err)
+		case !c.wantErr && (d.Id() != c.wantID || d.Get("adom") != c.wantAdom):
+			t.Errorf("%s: import = %s in ADOM %v, want %s in ADOM %s", c.id, d.Id(), d.Get("adom"), c.wantID, c.wantAdom)
+		}
+	}
+}
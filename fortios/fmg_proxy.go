@@ -0,0 +1,138 @@
+package fortios
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	fmgclient "github.com/fortinetdev/forti-sdk-go/fortimanager/sdkcore"
+	"github.com/fortinetdev/forti-sdk-go/fortios/auth"
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+)
+
+// When fmg_proxy_target is set, the FortiOS resources reach their FortiGate
+// through the FortiManager managing it: each request of the FortiOS REST API
+// is forwarded to the FortiGate by /sys/proxy/json, so that the FortiGates
+// only FortiManager can reach are managed as if they were reached directly.
+
+// fmgProxyTransport sends the FortiOS requests to the FortiGate target,
+// "adom/<adom>/device/<name>", through the FortiManager of fmg
+type fmgProxyTransport struct {
+	fmg    *fmgclient.FmgSDKClient
+	target string
+}
+
+func (t *fmgProxyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	data := map[string]interface{}{
+		"target": []string{t.target},
+		"action": strings.ToLower(req.Method),
+	}
+
+	if req.Body != nil {
+		b, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(b)) != 0 {
+			var payload interface{}
+			if err := json.Unmarshal(b, &payload); err != nil {
+				return nil, fmt.Errorf("cannot forward the request through FortiManager, its body is not JSON: %v", err)
+			}
+			data["payload"] = payload
+		}
+	}
+
+	// FortiManager authenticates the request on the FortiGate
+	q := req.URL.Query()
+	q.Del("access_token")
+	data["resource"] = req.URL.EscapedPath()
+	if s := q.Encode(); s != "" {
+		data["resource"] = req.URL.EscapedPath() + "?" + s
+	}
+
+	result, err := fmgCall(t.fmg, "exec", map[string]interface{}{
+		"url":  "/sys/proxy/json",
+		"data": data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("FortiManager proxy: %v", err)
+	}
+
+	l, _ := result[0]["data"].([]interface{})
+	if len(l) == 0 {
+		return nil, fmt.Errorf("FortiManager proxy: no response from %s", t.target)
+	}
+
+	r, _ := l[0].(map[string]interface{})
+	st, _ := r["status"].(map[string]interface{})
+	if code, _ := st["code"].(float64); code != 0 {
+		return nil, fmt.Errorf("FortiManager cannot reach %s: %v", t.target, st["message"])
+	}
+
+	var b []byte
+	switch v := r["response"].(type) {
+	case string:
+		b = []byte(v)
+	default:
+		if b, err = json.Marshal(v); err != nil {
+			return nil, err
+		}
+	}
+
+	status := http.StatusOK
+	if o, ok := r["response"].(map[string]interface{}); ok {
+		if n, ok := o["http_status"].(float64); ok && n != 0 {
+			status = int(n)
+		}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          ioutil.NopCloser(bytes.NewReader(b)),
+		ContentLength: int64(len(b)),
+		Request:       req,
+	}, nil
+}
+
+// createFortiOSProxyClient creates the FortiOS client of the FortiGate
+// c.FMG_ProxyTarget reached through the FortiManager client of fClient
+func createFortiOSProxyClient(fClient *FortiClient, c *Config) error {
+	adom := c.FMG_ProxyAdom
+	if adom == "" {
+		adom = "root"
+	}
+	target := "adom/" + adom + "/device/" + c.FMG_ProxyTarget
+
+	auth := auth.NewAuth(c.FMG_ProxyTarget, "", "", "", "", "", "", "", c.Vdom, "", "", "", "")
+
+	fClient.cmdbReads = &cmdbReadTransport{
+		base: &fmgProxyTransport{
+			fmg:    fClient.ClientFortimanager,
+			target: target,
+		},
+	}
+	client := &http.Client{
+		Transport: fClient.cmdbReads,
+		Timeout:   time.Second * 250,
+	}
+
+	fc, err := forticlient.NewClient(auth, client)
+	if err != nil {
+		return fmt.Errorf("connection error through FortiManager to %s: %v", target, err)
+	}
+
+	fClient.Client = fc
+	fClient.FMGProxyTarget = target
+
+	return nil
+}
@@ -0,0 +1,312 @@
+package fortios
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	fmgclient "github.com/fortinetdev/forti-sdk-go/fortimanager/sdkcore"
+)
+
+// defaultFMGMaxConcurrentRequests is the default number of JSON-RPC calls
+// sent to FortiManager at the same time
+const defaultFMGMaxConcurrentRequests = 4
+
+// fmgTransport carries the JSON-RPC calls to FortiManager:
+//   - each call is sent with its own id, and the id of the response is checked
+//     before it is given back with the id of the caller
+//   - at most maxConcurrent calls are sent at the same time
+//   - a call refused because its session expired is retried with a new
+//     session
+//   - when token is set, it is sent as the bearer token of the calls instead
+//     of a session
+type fmgTransport struct {
+	base   http.RoundTripper
+	client *fmgclient.FmgSDKClient
+	token  string
+
+	lastID uint64
+	slots  chan struct{}
+	lock   sync.Mutex
+}
+
+func newFMGTransport(base http.RoundTripper, token string, maxConcurrent int) *fmgTransport {
+	if maxConcurrent <= 0 {
+		maxConcurrent = defaultFMGMaxConcurrentRequests
+	}
+
+	return &fmgTransport{
+		base:  base,
+		token: token,
+		slots: make(chan struct{}, maxConcurrent),
+	}
+}
+
+// fmgDecode decodes the JSON-RPC message b, keeping the numbers as they are
+func fmgDecode(b []byte) (map[string]interface{}, error) {
+	var v map[string]interface{}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// fmgInvalidSession reports whether the status of the JSON-RPC response o
+// means the session is no longer valid
+func fmgInvalidSession(o map[string]interface{}) bool {
+	l, _ := o["result"].([]interface{})
+	if len(l) == 0 {
+		return false
+	}
+
+	r, _ := l[0].(map[string]interface{})
+	st, _ := r["status"].(map[string]interface{})
+	code := fmt.Sprintf("%v", st["code"])
+	msg, _ := st["message"].(string)
+
+	return code == "-11" || strings.Contains(strings.ToLower(msg), "invalid session")
+}
+
+// fmgRequestURL returns the url of the first parameter of the JSON-RPC
+// request rpc
+func fmgRequestURL(rpc map[string]interface{}) string {
+	params, _ := rpc["params"].([]interface{})
+	if len(params) == 0 {
+		return ""
+	}
+
+	p, _ := params[0].(map[string]interface{})
+	url, _ := p["url"].(string)
+
+	return url
+}
+
+func (t *fmgTransport) send(req *http.Request, body []byte) (*http.Response, []byte, error) {
+	r := req.Clone(req.Context())
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	r.ContentLength = int64(len(body))
+	if t.token != "" {
+		r.Header.Set("Authorization", "Bearer "+t.token)
+	}
+
+	t.slots <- struct{}{}
+	defer func() { <-t.slots }()
+
+	resp, err := t.base.RoundTrip(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, b, nil
+}
+
+// call sends the JSON-RPC request rpc with a new id, and returns the response
+// once its id is checked
+func (t *fmgTransport) call(req *http.Request, rpc map[string]interface{}) (*http.Response, map[string]interface{}, []byte, error) {
+	id := atomic.AddUint64(&t.lastID, 1)
+	rpc["id"] = id
+
+	body, err := json.Marshal(rpc)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	resp, b, err := t.send(req, body)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	o, err := fmgDecode(b)
+	if err != nil {
+		// not a JSON-RPC response, such as an HTTP error page
+		return resp, nil, b, nil
+	}
+
+	if got := fmt.Sprintf("%v", o["id"]); got != fmt.Sprintf("%d", id) {
+		return nil, nil, nil, fmt.Errorf("FortiManager response id %s doesn't match the request id %d", got, id)
+	}
+
+	return resp, o, b, nil
+}
+
+func (t *fmgTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body == nil || t.client == nil {
+		if t.token != "" {
+			req = req.Clone(req.Context())
+			req.Header.Set("Authorization", "Bearer "+t.token)
+		}
+		return t.base.RoundTrip(req)
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	rpc, err := fmgDecode(body)
+	if err != nil {
+		resp, b, err := t.send(req, body)
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+		return resp, nil
+	}
+	callerID := rpc["id"]
+
+	resp, o, b, err := t.call(req, rpc)
+	if err != nil {
+		return nil, err
+	}
+
+	session, _ := rpc["session"].(string)
+	if o != nil && session != "" && fmgRequestURL(rpc) != "/sys/logout" && fmgInvalidSession(o) {
+		if session, err = t.renew(session); err != nil {
+			log.Printf("[WARN] cannot renew the FortiManager session: %v", err)
+		} else {
+			log.Printf("[DEBUG] FortiManager session expired, retrying with a new session")
+			rpc["session"] = session
+			if resp, o, b, err = t.call(req, rpc); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// the caller checks the response has the id of its request
+	if o != nil {
+		o["id"] = callerID
+		if nb, err := json.Marshal(o); err == nil {
+			b = nb
+		}
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+	resp.ContentLength = int64(len(b))
+
+	return resp, nil
+}
+
+// renew logs in again unless the session was already renewed since expired
+// was used, it returns the current session
+func (t *fmgTransport) renew(expired string) (string, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.client.SessionString != expired {
+		return t.client.SessionString, nil
+	}
+
+	session, err := fmgLogin(t.client)
+	if err != nil {
+		return "", err
+	}
+	t.client.SessionString = session
+
+	return session, nil
+}
+
+// fmgCall sends a JSON-RPC call with all the params to the FortiManager of c,
+// FortiManager runs them in turn so that several objects can be created in a
+// single call. It returns the result of each of the params, and an error
+// naming the first of them that failed.
+func fmgCall(c *fmgclient.FmgSDKClient, method string, params ...map[string]interface{}) ([]map[string]interface{}, error) {
+	if !c.Init {
+		return nil, fmt.Errorf("FortiManager connection did not initialize successfully!")
+	}
+
+	l := make([]interface{}, 0, len(params))
+	for _, p := range params {
+		l = append(l, p)
+	}
+
+	// the id is replaced by fmgTransport with a unique one
+	body, err := json.Marshal(map[string]interface{}{
+		"id":      1,
+		"method":  method,
+		"params":  l,
+		"session": c.SessionString,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode request: %v", err)
+	}
+
+	resp, err := c.Client.Post("https://"+c.Ipaddr+"/jsonrpc", "application/json", bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("cannot send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get response body: %v", err)
+	}
+
+	var o struct {
+		Result []map[string]interface{} `json:"result"`
+	}
+	if err := json.Unmarshal(b, &o); err != nil || len(o.Result) == 0 {
+		return nil, fmt.Errorf("No result got, details:\n%s", b)
+	}
+
+	for i, r := range o.Result {
+		st, _ := r["status"].(map[string]interface{})
+		code, _ := st["code"].(float64)
+		msg, _ := st["message"].(string)
+		if code != 0 || msg != "OK" {
+			url, _ := r["url"].(string)
+			if url == "" && i < len(params) {
+				url, _ = params[i]["url"].(string)
+			}
+			return o.Result, fmt.Errorf("status not right for %s: code is %d, message is %s", url, int(code), msg)
+		}
+	}
+
+	return o.Result, nil
+}
+
+// fmgRunTask runs url with data on the FortiManager of c, and waits for the
+// task it starts to complete within timeout minutes
+func fmgRunTask(c *fmgclient.FmgSDKClient, timeout int, url string, data map[string]interface{}) error {
+	task, err := fmgStartTask(c, url, data)
+	if err != nil {
+		return err
+	}
+
+	return c.QueryTask(task, timeout)
+}
+
+// fmgStartTask runs url with data on the FortiManager of c, and returns the
+// id of the task it starts
+func fmgStartTask(c *fmgclient.FmgSDKClient, url string, data map[string]interface{}) (int, error) {
+	result, err := fmgCall(c, "exec", map[string]interface{}{
+		"url":  url,
+		"data": data,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	r, _ := result[0]["data"].(map[string]interface{})
+	task, ok := r["task"].(float64)
+	if !ok {
+		return 0, fmt.Errorf("cannot get the task id of %s", url)
+	}
+
+	return int(task), nil
+}
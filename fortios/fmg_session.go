@@ -0,0 +1,144 @@
+package fortios
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	fmgclient "github.com/fortinetdev/forti-sdk-go/fortimanager/sdkcore"
+)
+
+// fmgSessionTTL is the time a FortiManager session kept in the session cache
+// is reused after its last use, below the default idle timeout of the
+// FortiManager administrators
+const fmgSessionTTL = 10 * time.Minute
+
+// fmgLogin opens a new session on the FortiManager of c
+func fmgLogin(c *fmgclient.FmgSDKClient) (string, error) {
+	params := map[string]interface{}{
+		"data": map[string]string{
+			"user":   c.User,
+			"passwd": c.Passwd,
+		},
+		"url": "/sys/login/user",
+	}
+
+	req := &fmgclient.Request{
+		Id:     1,
+		Method: "exec",
+		Params: [1]interface{}{params},
+	}
+
+	result, err := c.Execute(req)
+	if err != nil {
+		return "", fmt.Errorf("login failed: %v", err)
+	}
+
+	session, _ := result["session"].(string)
+	if session == "" {
+		return "", fmt.Errorf("login failed: no session returned")
+	}
+
+	return session, nil
+}
+
+// fmgCachedSession is a FortiManager session kept in the session cache file
+type fmgCachedSession struct {
+	Session string    `json:"session"`
+	Expires time.Time `json:"expires"`
+}
+
+var fmgSessionCacheLock sync.Mutex
+
+func fmgSessionCacheKey(c *fmgclient.FmgSDKClient) string {
+	return c.User + "@" + c.Ipaddr
+}
+
+func readFmgSessionCache(path string) map[string]fmgCachedSession {
+	sessions := make(map[string]fmgCachedSession)
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return sessions
+	}
+	if err := json.Unmarshal(b, &sessions); err != nil {
+		log.Printf("[WARN] ignoring the invalid FortiManager session cache %s: %v", path, err)
+	}
+
+	return sessions
+}
+
+// loadFmgSession returns the session of c kept in the session cache file path
+// if it has not expired
+func loadFmgSession(path string, c *fmgclient.FmgSDKClient) string {
+	fmgSessionCacheLock.Lock()
+	defer fmgSessionCacheLock.Unlock()
+
+	s, ok := readFmgSessionCache(path)[fmgSessionCacheKey(c)]
+	if !ok || time.Now().After(s.Expires) {
+		return ""
+	}
+
+	return s.Session
+}
+
+// saveFmgSession keeps the session of c in the session cache file path, and
+// drops the expired sessions from it
+func saveFmgSession(path string, c *fmgclient.FmgSDKClient) error {
+	fmgSessionCacheLock.Lock()
+	defer fmgSessionCacheLock.Unlock()
+
+	sessions := readFmgSessionCache(path)
+	now := time.Now()
+	for k, s := range sessions {
+		if now.After(s.Expires) {
+			delete(sessions, k)
+		}
+	}
+	sessions[fmgSessionCacheKey(c)] = fmgCachedSession{
+		Session: c.SessionString,
+		Expires: now.Add(fmgSessionTTL),
+	}
+
+	b, err := json.Marshal(sessions)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// closeFortiManager keeps the session in the session cache if there is one,
+// or logs out
+func (f *FortiClient) closeFortiManager() {
+	c := f.ClientFortimanager
+	if c == nil || !c.Init || c.SessionString == "" {
+		return
+	}
+
+	if f.FMGSessionCache != "" {
+		if err := saveFmgSession(f.FMGSessionCache, c); err != nil {
+			log.Printf("[WARN] cannot save the FortiManager session in %s: %v", f.FMGSessionCache, err)
+		}
+		return
+	}
+
+	if err := c.Logout(c.SessionString); err != nil {
+		log.Printf("[WARN] cannot log out of FortiManager: %v", err)
+	}
+	c.SessionString = ""
+}
@@ -0,0 +1,274 @@
+package fortios
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// When the workspace mode of FortiManager is enabled, the ADOMs must be
+// locked before they are changed and the changes committed. The resources
+// writing the objects and the policies of an ADOM lock it before they write,
+// unless other writes of the provider already hold the lock, and the last of
+// these writes to return commits the changes and unlocks the ADOM, even if
+// it failed. The ADOMs are committed before their policy packages are
+// installed.
+
+// fmgWorkspaceWrites are the FortiManager resources changing the database of
+// their ADOM
+var fmgWorkspaceWrites = map[string]bool{
+	"fortios_fmg_firewall_object_address":            true,
+	"fortios_fmg_firewall_object_addressgroup":       true,
+	"fortios_fmg_firewall_object_ippool":             true,
+	"fortios_fmg_firewall_object_profilegroup":       true,
+	"fortios_fmg_firewall_object_schedule_onetime":   true,
+	"fortios_fmg_firewall_object_schedule_recurring": true,
+	"fortios_fmg_firewall_object_service":            true,
+	"fortios_fmg_firewall_object_servicegroup":       true,
+	"fortios_fmg_firewall_object_vip":                true,
+	"fortios_fmg_firewall_object_vipgroup":           true,
+	"fortios_fmg_firewall_security_policy":           true,
+	"fortios_fmg_firewall_security_policypackage":    true,
+	"fortios_fmg_object_cli_template_assignment":     true,
+	"fortios_fmg_object_metadata_variable":           true,
+	"fortios_fmg_object_metadata_variable_mapping":   true,
+	"fortios_fmg_object_zone":                        true,
+	"fortios_fmg_securityprofile":                    true,
+}
+
+// fmgWorkspaceInstalls are the FortiManager resources installing the
+// database of their ADOM
+var fmgWorkspaceInstalls = map[string]bool{
+	"fortios_fmg_devicemanager_install_device":        true,
+	"fortios_fmg_devicemanager_install_policypackage": true,
+}
+
+// configureFMGWorkspace adds the workspace handling to the FortiManager
+// resource name
+func configureFMGWorkspace(name string, r *schema.Resource) {
+	var wrap func(fn func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error
+
+	switch {
+	case fmgWorkspaceWrites[name]:
+		wrap = fmgWorkspaceWrap
+	case fmgWorkspaceInstalls[name]:
+		wrap = fmgWorkspaceInstallWrap
+	default:
+		return
+	}
+
+	r.Create = wrap(r.Create)
+	r.Update = wrap(r.Update)
+	r.Delete = wrap(r.Delete)
+}
+
+// fmgWorkspaceWrap holds the lock of the ADOM of the resource while fn
+// writes to it
+func fmgWorkspaceWrap(fn func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if fn == nil {
+		return nil
+	}
+
+	return func(d *schema.ResourceData, m interface{}) error {
+		f := m.(*FortiClient)
+		adom, _ := d.Get("adom").(string)
+
+		locked, err := f.beginFMGWorkspace(adom)
+		if err != nil {
+			return err
+		}
+		if !locked {
+			return fn(d, m)
+		}
+
+		err = fn(d, m)
+		if cerr := f.endFMGWorkspace(adom, err == nil); cerr != nil {
+			if err != nil {
+				log.Printf("[WARN] %v", cerr)
+				return err
+			}
+			return cerr
+		}
+
+		return err
+	}
+}
+
+// fmgWorkspaceInstallWrap commits the ADOM of the resource before fn
+// installs it
+func fmgWorkspaceInstallWrap(fn func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if fn == nil {
+		return nil
+	}
+
+	return func(d *schema.ResourceData, m interface{}) error {
+		f := m.(*FortiClient)
+		adom, _ := d.Get("adom").(string)
+
+		if err := f.commitFMGWorkspace(adom); err != nil {
+			return err
+		}
+
+		return fn(d, m)
+	}
+}
+
+// fmgResultData returns the data of the first result of the FortiManager
+// response o
+func fmgResultData(o map[string]interface{}) map[string]interface{} {
+	l, _ := o["result"].([]interface{})
+	if len(l) == 0 {
+		return nil
+	}
+
+	r, _ := l[0].(map[string]interface{})
+	data, _ := r["data"].(map[string]interface{})
+
+	return data
+}
+
+// fmgWorkspaceEnabled reports whether the mode value v, as returned by
+// FortiManager as a name or a number, enables the workspace
+func fmgWorkspaceEnabled(v interface{}) bool {
+	switch v := v.(type) {
+	case string:
+		return v != "" && v != "disable" && v != "0"
+	case float64:
+		return v != 0
+	}
+
+	return false
+}
+
+// isFMGWorkspace reports whether the ADOM adom is in workspace mode, the
+// mode is read once for the lifetime of the provider. f.fmgWorkspaceLock
+// must be held.
+func (f *FortiClient) isFMGWorkspace(adom string) (bool, error) {
+	c := f.ClientFortimanager
+
+	if f.fmgWorkspaceMode == nil {
+		o, err := c.Do("get", map[string]interface{}{
+			"url": "/cli/global/system/global",
+		})
+		if err != nil {
+			return false, fmt.Errorf("cannot read the workspace mode: %v", err)
+		}
+
+		f.fmgWorkspaceMode = make(map[string]bool)
+		f.fmgWorkspaceGlobal = fmgResultData(o)["workspace-mode"]
+	}
+
+	// in per-adom mode, each ADOM has its own workspace mode
+	if v, ok := f.fmgWorkspaceGlobal.(string); !ok || v != "per-adom" {
+		return fmgWorkspaceEnabled(f.fmgWorkspaceGlobal), nil
+	}
+
+	if enabled, ok := f.fmgWorkspaceMode[adom]; ok {
+		return enabled, nil
+	}
+
+	o, err := c.Do("get", map[string]interface{}{
+		"url": "/dvmdb/adom/" + adom,
+	})
+	if err != nil {
+		return false, fmt.Errorf("cannot read the workspace mode of ADOM %s: %v", adom, err)
+	}
+
+	f.fmgWorkspaceMode[adom] = fmgWorkspaceEnabled(fmgResultData(o)["workspace_mode"])
+
+	return f.fmgWorkspaceMode[adom], nil
+}
+
+func (f *FortiClient) execFMGWorkspace(adom, action string) error {
+	_, err := f.ClientFortimanager.Do("exec", map[string]interface{}{
+		"url": "/dvmdb/adom/" + adom + "/workspace/" + action,
+	})
+	return err
+}
+
+// beginFMGWorkspace locks the ADOM adom for a write if it is in workspace
+// mode and the lock is not held by other writes yet. It returns whether the
+// write holds the lock, it must then call endFMGWorkspace.
+func (f *FortiClient) beginFMGWorkspace(adom string) (bool, error) {
+	f.fmgWorkspaceLock.Lock()
+	defer f.fmgWorkspaceLock.Unlock()
+
+	if f.fmgAdomWrites[adom] > 0 {
+		f.fmgAdomWrites[adom]++
+		return true, nil
+	}
+
+	enabled, err := f.isFMGWorkspace(adom)
+	if err != nil || !enabled {
+		return false, err
+	}
+
+	log.Printf("[DEBUG] locking FortiManager ADOM %s", adom)
+	if err := f.execFMGWorkspace(adom, "lock"); err != nil {
+		return false, fmt.Errorf("Error locking FortiManager ADOM %s: %v", adom, err)
+	}
+
+	if f.fmgAdomWrites == nil {
+		f.fmgAdomWrites = make(map[string]int)
+		f.fmgAdomChanged = make(map[string]bool)
+	}
+	f.fmgAdomWrites[adom] = 1
+
+	return true, nil
+}
+
+// endFMGWorkspace ends a write begun with beginFMGWorkspace, changed
+// reporting whether it succeeded. The last write holding the lock commits the
+// changes, if any write succeeded, and unlocks the ADOM.
+func (f *FortiClient) endFMGWorkspace(adom string, changed bool) error {
+	f.fmgWorkspaceLock.Lock()
+	defer f.fmgWorkspaceLock.Unlock()
+
+	if changed {
+		f.fmgAdomChanged[adom] = true
+	}
+
+	f.fmgAdomWrites[adom]--
+	if f.fmgAdomWrites[adom] > 0 {
+		return nil
+	}
+
+	changed = f.fmgAdomChanged[adom]
+	delete(f.fmgAdomWrites, adom)
+	delete(f.fmgAdomChanged, adom)
+
+	var err error
+	if changed {
+		log.Printf("[DEBUG] committing FortiManager ADOM %s", adom)
+		if cerr := f.execFMGWorkspace(adom, "commit"); cerr != nil {
+			err = fmt.Errorf("Error committing FortiManager ADOM %s: %v", adom, cerr)
+		}
+	}
+
+	log.Printf("[DEBUG] unlocking FortiManager ADOM %s", adom)
+	if uerr := f.execFMGWorkspace(adom, "unlock"); uerr != nil && err == nil {
+		err = fmt.Errorf("Error unlocking FortiManager ADOM %s: %v", adom, uerr)
+	}
+
+	return err
+}
+
+// commitFMGWorkspace commits the changes made to the ADOM adom if writes of
+// the provider hold its lock
+func (f *FortiClient) commitFMGWorkspace(adom string) error {
+	f.fmgWorkspaceLock.Lock()
+	defer f.fmgWorkspaceLock.Unlock()
+
+	if f.fmgAdomWrites[adom] == 0 || !f.fmgAdomChanged[adom] {
+		return nil
+	}
+
+	log.Printf("[DEBUG] committing FortiManager ADOM %s", adom)
+	if err := f.execFMGWorkspace(adom, "commit"); err != nil {
+		return fmt.Errorf("Error committing FortiManager ADOM %s: %v", adom, err)
+	}
+	f.fmgAdomChanged[adom] = false
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package fortios
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	fmgclient "github.com/fortinetdev/forti-sdk-go/fortimanager/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFMGWorkspaceWrap(t *testing.T) {
+	errWrite := errors.New("write failed")
+
+	cases := []struct {
+		name   string
+		writes []error
+		want   []string
+	}{
+		{"write", []error{nil}, []string{"lock", "commit", "unlock"}},
+		{"failed write", []error{errWrite}, []string{"lock", "unlock"}},
+		{"writes", []error{nil, nil}, []string{"lock", "commit", "unlock", "lock", "commit", "unlock"}},
+	}
+
+	for _, c := range cases {
+		var lock sync.Mutex
+		var calls []string
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				Params []map[string]interface{} `json:"params"`
+			}
+			json.NewDecoder(r.Body).Decode(&req)
+			url, _ := req.Params[0]["url"].(string)
+
+			data := map[string]interface{}{}
+			if url == "/cli/global/system/global" {
+				data["workspace-mode"] = "normal"
+			} else {
+				lock.Lock()
+				calls = append(calls, url[strings.LastIndex(url, "/")+1:])
+				lock.Unlock()
+			}
+
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"id": 1,
+				"result": []interface{}{map[string]interface{}{
+					"status": map[string]interface{}{"code": 0, "message": "OK"},
+					"data":   data,
+				}},
+			})
+		}))
+
+		fc := fmgclient.NewClient(strings.TrimPrefix(server.URL, "https://"), "", "", server.Client())
+		fc.Init = true
+		f := &FortiClient{ClientFortimanager: fc}
+
+		r := &schema.Resource{Schema: map[string]*schema.Schema{
+			"adom": {Type: schema.TypeString, Optional: true},
+		}}
+		d := r.Data(nil)
+		d.Set("adom", "root")
+
+		for _, werr := range c.writes {
+			werr := werr
+			fn := fmgWorkspaceWrap(func(*schema.ResourceData, interface{}) error {
+				return werr
+			})
+			if err := fn(d, f); err != werr {
+				t.Errorf("%s: write returned %v, want %v", c.name, err, werr)
+			}
+		}
+		server.Close()
+
+		if !reflect.DeepEqual(calls, c.want) {
+			t.Errorf("%s: workspace calls = %v, want %v", c.name, calls, c.want)
+		}
+	}
+}
+
+func TestFMGWorkspaceBatch(t *testing.T) {
+	f := &FortiClient{fmgAdomWrites: map[string]int{"root": 1}, fmgAdomChanged: map[string]bool{}}
+
+	// the writes begun while the lock is held don't lock the ADOM again
+	if locked, err := f.beginFMGWorkspace("root"); !locked || err != nil {
+		t.Fatalf("beginFMGWorkspace() = %v, %v, want true, nil", locked, err)
+	}
+	if err := f.endFMGWorkspace("root", true); err != nil {
+		t.Fatalf("endFMGWorkspace() = %v", err)
+	}
+	if f.fmgAdomWrites["root"] != 1 || !f.fmgAdomChanged["root"] {
+		t.Errorf("after a write of the batch, writes = %d, changed = %v, want 1, true", f.fmgAdomWrites["root"], f.fmgAdomChanged["root"])
+	}
+}
@@ -0,0 +1,237 @@
+package fortios
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// haSyncInterval is the interval between two reads of the HA checksums
+const haSyncInterval = 2 * time.Second
+
+// configureHASync makes the writes of r wait for the members of the HA
+// cluster to synchronize their configuration, the members still out of sync
+// after ha_sync_timeout are reported as warnings
+func configureHASync(r *schema.Resource) {
+	if r.Create != nil {
+		r.CreateContext = haSyncWrap(r.Create)
+		r.Create = nil
+	}
+	if r.Update != nil {
+		r.UpdateContext = haSyncWrap(r.Update)
+		r.Update = nil
+	}
+	if r.Delete != nil {
+		r.DeleteContext = haSyncWrap(r.Delete)
+		r.Delete = nil
+	}
+}
+
+func haSyncWrap(fn func(*schema.ResourceData, interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		f, ok := m.(*FortiClient)
+		if !ok || f == nil || f.Client == nil || f.HASyncTimeout == 0 || !f.isHACluster() {
+			return diag.FromErr(fn(d, m))
+		}
+
+		// the checksums before the write tell the synchronization of the
+		// write from the one of the previous state
+		before, err := f.readHAChecksums()
+		if err != nil {
+			log.Printf("[WARN] cannot read the HA checksums: %v", err)
+		}
+
+		if err := fn(d, m); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return f.waitHASync(ctx, before)
+	}
+}
+
+// isHACluster reports whether the FortiGate is a member of an HA cluster, it
+// is read once for the lifetime of the provider
+func (f *FortiClient) isHACluster() bool {
+	f.haModeLock.Lock()
+	defer f.haModeLock.Unlock()
+
+	if f.haMode == "" {
+		o, err := cmdbRead(f.Client, "system/ha", nil, "")
+		if err != nil {
+			log.Printf("[WARN] cannot read the HA mode: %v", err)
+			return false
+		}
+
+		f.haMode, _ = o["mode"].(string)
+		if f.haMode == "" {
+			f.haMode = "standalone"
+		}
+	}
+
+	return f.haMode != "standalone"
+}
+
+// haMember is the configuration checksums of a member of the HA cluster
+type haMember struct {
+	name      string
+	primary   bool
+	checksums map[string]string
+}
+
+func (f *FortiClient) readHAChecksums() ([]haMember, error) {
+	o, err := monitorRead(f.Client, "system/ha-checksums", "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	var members []haMember
+
+	l, _ := o["results"].([]interface{})
+	for _, i := range l {
+		e, ok := i.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		mb := haMember{checksums: make(map[string]string)}
+		mb.name, _ = e["serial_no"].(string)
+		if v, ok := e["hostname"].(string); ok && v != "" {
+			mb.name = fmt.Sprintf("%s (%s)", v, mb.name)
+		}
+		if v, ok := e["is_root_master"].(float64); ok && v == 1 {
+			mb.primary = true
+		}
+		if v, ok := e["is_manage_master"].(float64); ok && v == 1 {
+			mb.primary = true
+		}
+		if cs, ok := e["checksum"].(map[string]interface{}); ok {
+			for k, v := range cs {
+				mb.checksums[k] = fmt.Sprintf("%v", v)
+			}
+		}
+
+		members = append(members, mb)
+	}
+
+	return members, nil
+}
+
+// haPrimary returns the primary of members, nil if there is none
+func haPrimary(members []haMember) *haMember {
+	for i := range members {
+		if members[i].primary {
+			return &members[i]
+		}
+	}
+
+	return nil
+}
+
+// haSameChecksums reports whether the checksums of mb are the ones of primary
+func haSameChecksums(mb, primary *haMember) bool {
+	for k, v := range primary.checksums {
+		if mb.checksums[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// haOutOfSync returns the members whose checksums differ from the ones of the
+// primary, the members already out of sync before the write, in before, are
+// left out
+func haOutOfSync(before, members []haMember) []string {
+	primary := haPrimary(members)
+	if primary == nil {
+		return nil
+	}
+
+	skip := make(map[string]bool)
+	if bp := haPrimary(before); bp != nil {
+		for i, mb := range before {
+			if !mb.primary && !haSameChecksums(&before[i], bp) {
+				skip[mb.name] = true
+			}
+		}
+	}
+
+	var names []string
+	for i, mb := range members {
+		if mb.primary || skip[mb.name] {
+			continue
+		}
+		if !haSameChecksums(&members[i], primary) {
+			names = append(names, mb.name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// haPrimaryChanged reports whether the checksums of the primary differ from
+// the ones before the write, true if they are unknown
+func haPrimaryChanged(before, members []haMember) bool {
+	bp, primary := haPrimary(before), haPrimary(members)
+	if bp == nil || primary == nil {
+		return true
+	}
+
+	return !haSameChecksums(primary, bp)
+}
+
+// waitHASync waits for the primary of the HA cluster to report the write, its
+// checksums changing from the ones before the write, and for the members in
+// sync before the write to report the checksums of the primary. The writes
+// leaving the checksums of the primary unchanged, such as the updates
+// changing nothing, return once two reads in a row find the members in sync,
+// the primary taking a moment to report the writes.
+func (f *FortiClient) waitHASync(ctx context.Context, before []haMember) diag.Diagnostics {
+	deadline := time.Now().Add(time.Duration(f.HASyncTimeout) * time.Second)
+	unchanged := false
+
+	for {
+		members, err := f.readHAChecksums()
+
+		var outOfSync []string
+		if err == nil {
+			changed := haPrimaryChanged(before, members)
+			outOfSync = haOutOfSync(before, members)
+			if len(outOfSync) == 0 && (changed || unchanged) {
+				return nil
+			}
+			unchanged = !changed && len(outOfSync) == 0
+		}
+
+		if time.Now().After(deadline) {
+			if err != nil {
+				return diag.Diagnostics{{
+					Severity: diag.Warning,
+					Summary:  "Cannot check the configuration synchronization of the HA cluster",
+					Detail:   err.Error(),
+				}}
+			}
+			if len(outOfSync) == 0 {
+				return nil
+			}
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  "HA cluster members out of sync",
+				Detail:   fmt.Sprintf("The configuration of the following members still differs from the primary after %d seconds: %s", f.HASyncTimeout, strings.Join(outOfSync, ", ")),
+			}}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(haSyncInterval):
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package fortios
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestHAOutOfSync(t *testing.T) {
+	member := func(name string, primary bool, sum string) haMember {
+		return haMember{name: name, primary: primary, checksums: map[string]string{"all": sum}}
+	}
+
+	cases := []struct {
+		name    string
+		before  []haMember
+		members []haMember
+		want    []string
+		changed bool
+	}{
+		{
+			"in sync",
+			[]haMember{member("fgt1", true, "a"), member("fgt2", false, "a")},
+			[]haMember{member("fgt1", true, "b"), member("fgt2", false, "b")},
+			nil,
+			true,
+		},
+		{
+			"not synchronized yet",
+			[]haMember{member("fgt1", true, "a"), member("fgt2", false, "a")},
+			[]haMember{member("fgt1", true, "b"), member("fgt2", false, "a")},
+			[]string{"fgt2"},
+			true,
+		},
+		{
+			"write not reported yet",
+			[]haMember{member("fgt1", true, "a"), member("fgt2", false, "a")},
+			[]haMember{member("fgt1", true, "a"), member("fgt2", false, "a")},
+			nil,
+			false,
+		},
+		{
+			"out of sync before the write",
+			[]haMember{member("fgt1", true, "a"), member("fgt2", false, "x"), member("fgt3", false, "a")},
+			[]haMember{member("fgt1", true, "b"), member("fgt2", false, "x"), member("fgt3", false, "b")},
+			nil,
+			true,
+		},
+		{
+			"no checksums before the write",
+			nil,
+			[]haMember{member("fgt1", true, "b"), member("fgt2", false, "a")},
+			[]string{"fgt2"},
+			true,
+		},
+		{
+			"no primary",
+			nil,
+			[]haMember{member("fgt1", false, "b"), member("fgt2", false, "a")},
+			nil,
+			true,
+		},
+	}
+
+	for _, c := range cases {
+		if got := haOutOfSync(c.before, c.members); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: haOutOfSync() = %v, want %v", c.name, got, c.want)
+		}
+		if got := haPrimaryChanged(c.before, c.members); got != c.changed {
+			t.Errorf("%s: haPrimaryChanged() = %v, want %v", c.name, got, c.changed)
+		}
+	}
+}
+
+func TestWaitHASync(t *testing.T) {
+	cases := []struct {
+		name      string
+		primary   string
+		secondary string
+		reads     int32
+		warning   bool
+	}{
+		{"synchronized", "b", "b", 1, false},
+		{"write changing nothing", "a", "a", 2, false},
+		{"out of sync", "b", "a", 0, true},
+	}
+
+	for _, c := range cases {
+		var reads int32
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			result := map[string]interface{}{"status": "success", "http_status": 200, "version": "v7.0.5", "serial": "FGVM"}
+			if strings.HasSuffix(r.URL.Path, "/system/ha-checksums") {
+				atomic.AddInt32(&reads, 1)
+				result["results"] = []interface{}{
+					map[string]interface{}{"serial_no": "FGVM1", "is_root_master": 1, "checksum": map[string]interface{}{"all": c.primary}},
+					map[string]interface{}{"serial_no": "FGVM2", "is_root_master": 0, "checksum": map[string]interface{}{"all": c.secondary}},
+				}
+			}
+			json.NewEncoder(w).Encode(result)
+		}))
+
+		insecure := true
+		m, err := (&Config{Hostname: strings.TrimPrefix(server.URL, "https://"), Token: "x", Insecure: &insecure}).CreateClient()
+		if err != nil {
+			t.Fatal(err)
+		}
+		f := m.(*FortiClient)
+		f.HASyncTimeout = 3
+
+		before := []haMember{
+			{name: "FGVM1", primary: true, checksums: map[string]string{"all": "a"}},
+			{name: "FGVM2", checksums: map[string]string{"all": "a"}},
+		}
+
+		atomic.StoreInt32(&reads, 0)
+		diags := f.waitHASync(context.Background(), before)
+		server.Close()
+
+		if got := len(diags) != 0; got != c.warning {
+			t.Errorf("%s: waitHASync() = %v, want a warning %v", c.name, diags, c.warning)
+		}
+		if n := atomic.LoadInt32(&reads); c.reads != 0 && n != c.reads {
+			t.Errorf("%s: waitHASync() read the checksums %d times, want %d", c.name, n, c.reads)
+		}
+	}
+}
@@ -0,0 +1,665 @@
+package fortios
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"strconv"
+	"strings"
+	"sync"
+
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// managementTarget describes how the provider reaches the FortiGate
+type managementTarget struct {
+	// host is the address of the FortiGate the provider connects to
+	host net.IP
+	// port is the HTTPS port the provider connects to
+	port int
+	// source is the address of the provider, nil behind an HTTP proxy
+	source net.IP
+	// intf is the FortiGate interface holding host, empty if none does
+	intf string
+}
+
+// managementLockoutCheck checks the changes of the attributes keys of a
+// resource that can cut the provider off the FortiGate
+type managementLockoutCheck struct {
+	keys  []string
+	check func(*schema.ResourceDiff, *FortiClient, *managementTarget) error
+}
+
+// managementLockoutChecks lists the resources whose changes can cut the
+// provider off the FortiGate
+var managementLockoutChecks = map[string]managementLockoutCheck{
+	"fortios_system_interface": {
+		[]string{"allowaccess", "status", "ip"},
+		lockoutCheckSystemInterface,
+	},
+	"fortios_system_admin": {
+		[]string{"trusthost1", "trusthost2", "trusthost3", "trusthost4", "trusthost5", "trusthost6", "trusthost7", "trusthost8", "trusthost9", "trusthost10",
+			"ip6_trusthost1", "ip6_trusthost2", "ip6_trusthost3", "ip6_trusthost4", "ip6_trusthost5", "ip6_trusthost6", "ip6_trusthost7", "ip6_trusthost8", "ip6_trusthost9", "ip6_trusthost10"},
+		lockoutCheckSystemAdmin,
+	},
+	"fortios_system_apiuser": {
+		[]string{"trusthost"},
+		lockoutCheckSystemApiUser,
+	},
+	"fortios_system_global": {
+		[]string{"admin_sport"},
+		lockoutCheckSystemGlobal,
+	},
+	"fortios_firewall_localinpolicy": {
+		[]string{"status", "action", "intf", "srcaddr", "srcaddr_negate", "dstaddr", "dstaddr_negate", "service", "service_negate"},
+		lockoutCheckFirewallLocalInPolicy,
+	},
+}
+
+// managementLockoutCustomizeDiff refuses the changes of the resource name
+// that would remove the access of the provider to the FortiGate, unless
+// allow_management_lockout is set
+func managementLockoutCustomizeDiff(name string) schema.CustomizeDiffFunc {
+	lc, ok := managementLockoutChecks[name]
+	if !ok {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		f, ok := m.(*FortiClient)
+		if !ok || f == nil || f.Client == nil || f.AllowManagementLockout || !d.HasChanges(lc.keys...) {
+			return nil
+		}
+
+		t := f.ManagementTarget()
+		if t == nil {
+			return nil
+		}
+
+		if err := lc.check(d, f, t); err != nil {
+			return fmt.Errorf("the change of %s would lock the provider out of the FortiGate: %v. Set the provider argument allow_management_lockout to true to apply it anyway", name, err)
+		}
+
+		return nil
+	}
+}
+
+// ManagementTarget returns how the provider reaches the FortiGate, it is
+// determined once for the lifetime of the provider. nil is returned if it
+// can't be determined.
+func (f *FortiClient) ManagementTarget() *managementTarget {
+	f.managementTargetLock.Lock()
+	defer f.managementTargetLock.Unlock()
+
+	if f.managementTargetDone {
+		return f.managementTarget
+	}
+	f.managementTargetDone = true
+
+	t, err := f.readManagementTarget()
+	if err != nil {
+		log.Printf("[WARN] cannot determine how the provider reaches the FortiGate, the management lockout checks are disabled: %v", err)
+		return nil
+	}
+	log.Printf("[DEBUG] the provider reaches the FortiGate at %s:%d on interface %q from %s", t.host, t.port, t.intf, t.source)
+
+	f.managementTarget = t
+
+	return t
+}
+
+func (f *FortiClient) readManagementTarget() (*managementTarget, error) {
+	c := f.Client
+
+	if f.FMGProxyTarget != "" {
+		return nil, fmt.Errorf("the FortiGate is reached through FortiManager")
+	}
+
+	host, port := c.Config.FwTarget, "443"
+	if h, p, err := net.SplitHostPort(c.Config.FwTarget); err == nil {
+		host, port = h, p
+	}
+
+	t := &managementTarget{}
+
+	var err error
+	if t.port, err = strconv.Atoi(port); err != nil {
+		return nil, fmt.Errorf("invalid port %s", port)
+	}
+
+	// the addresses are the ones of the connections of the provider, no
+	// name is resolved, behind a proxy only an address given as hostname is
+	// known
+	if c.Config.Auth == nil || c.Config.Auth.HTTPProxy == "" {
+		if f.managementConns == nil {
+			return nil, fmt.Errorf("the connections to the FortiGate are not known")
+		}
+		local, remote := f.managementConns.addrs()
+		if remote == nil {
+			if _, err := monitorRead(c, "system/status", "", ""); err != nil {
+				return nil, err
+			}
+			local, remote = f.managementConns.addrs()
+		}
+		if local == nil || remote == nil {
+			return nil, fmt.Errorf("no connection to the FortiGate")
+		}
+		t.host, t.source = remote.IP, local.IP
+	} else if t.host = net.ParseIP(host); t.host == nil {
+		return nil, fmt.Errorf("the FortiGate is reached through a proxy by name")
+	}
+
+	err = f.GenericGroupReadEach("/api/v2/cmdb/system/interface", "", "", func(o map[string]interface{}) error {
+		name, _ := o["name"].(string)
+		if ip, _ := o["ip"].(string); lockoutParseAddress(ip).IP.Equal(t.host) {
+			t.intf = name
+		}
+		if v6, ok := o["ipv6"].(map[string]interface{}); ok {
+			if ip, _ := v6["ip6-address"].(string); lockoutParseAddress(ip).IP.Equal(t.host) {
+				t.intf = name
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// managementConnsTransport records the addresses of the last connection the
+// requests to the FortiGate were sent through
+type managementConnsTransport struct {
+	base http.RoundTripper
+
+	lock   sync.Mutex
+	local  *net.TCPAddr
+	remote *net.TCPAddr
+}
+
+func (t *managementConnsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	trace := &httptrace.ClientTrace{
+		GotConn: func(i httptrace.GotConnInfo) {
+			local, _ := i.Conn.LocalAddr().(*net.TCPAddr)
+			remote, _ := i.Conn.RemoteAddr().(*net.TCPAddr)
+
+			t.lock.Lock()
+			t.local, t.remote = local, remote
+			t.lock.Unlock()
+		},
+	}
+
+	return t.base.RoundTrip(req.WithContext(httptrace.WithClientTrace(req.Context(), trace)))
+}
+
+// CloseIdleConnections closes the idle connections of the underlying
+// transport
+func (t *managementConnsTransport) CloseIdleConnections() {
+	if c, ok := t.base.(interface{ CloseIdleConnections() }); ok {
+		c.CloseIdleConnections()
+	}
+}
+
+func (t *managementConnsTransport) addrs() (*net.TCPAddr, *net.TCPAddr) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.local, t.remote
+}
+
+// lockoutParseAddress parses the FortiOS addresses "ip mask", "ip/len" and
+// "ip", an empty network is returned on error
+func lockoutParseAddress(s string) *net.IPNet {
+	s = strings.TrimSpace(s)
+
+	if _, n, err := net.ParseCIDR(s); err == nil {
+		if ip, _, _ := net.ParseCIDR(s); ip != nil {
+			n.IP = ip
+		}
+		return n
+	}
+
+	parts := strings.Fields(s)
+	if len(parts) == 0 {
+		return &net.IPNet{}
+	}
+
+	ip := net.ParseIP(parts[0])
+	if ip == nil {
+		return &net.IPNet{}
+	}
+
+	if len(parts) == 2 {
+		if mask := net.ParseIP(parts[1]).To4(); mask != nil {
+			return &net.IPNet{IP: ip, Mask: net.IPMask(mask)}
+		}
+	}
+
+	bits := 128
+	if ip.To4() != nil {
+		bits = 32
+	}
+
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+}
+
+// lockoutTrusted reports whether ip is allowed by the trusted hosts hosts, no
+// trusted host allows any address
+func lockoutTrusted(ip net.IP, hosts []string) bool {
+	any := true
+
+	for _, h := range hosts {
+		n := lockoutParseAddress(h)
+		if n.IP == nil {
+			continue
+		}
+		any = false
+		if n.Contains(ip) {
+			return true
+		}
+	}
+
+	return any
+}
+
+func lockoutNewString(d *schema.ResourceDiff, key string) (string, bool) {
+	if !d.HasChange(key) || !d.NewValueKnown(key) {
+		return "", false
+	}
+
+	v, ok := d.Get(key).(string)
+
+	return v, ok && v != ""
+}
+
+func lockoutCheckSystemInterface(d *schema.ResourceDiff, f *FortiClient, t *managementTarget) error {
+	if t.intf == "" || d.Get("name").(string) != t.intf {
+		return nil
+	}
+
+	if v, ok := lockoutNewString(d, "allowaccess"); ok {
+		https := false
+		for _, a := range strings.Fields(v) {
+			if a == "https" {
+				https = true
+			}
+		}
+		if !https {
+			return fmt.Errorf("allowaccess of interface %s does not include https", t.intf)
+		}
+	}
+
+	if v, ok := lockoutNewString(d, "status"); ok && v == "down" {
+		return fmt.Errorf("interface %s would be brought down", t.intf)
+	}
+
+	if v, ok := lockoutNewString(d, "ip"); ok && t.host.To4() != nil && !lockoutParseAddress(v).IP.Equal(t.host) {
+		return fmt.Errorf("interface %s would no longer hold %s", t.intf, t.host)
+	}
+
+	return nil
+}
+
+func lockoutCheckSystemAdmin(d *schema.ResourceDiff, f *FortiClient, t *managementTarget) error {
+	auth := f.Client.Config.Auth
+	if t.source == nil || auth == nil || auth.PassAuth != "enable" || d.Get("name").(string) != auth.Username {
+		return nil
+	}
+
+	prefix := "trusthost"
+	if t.source.To4() == nil {
+		prefix = "ip6_trusthost"
+	}
+
+	changed := false
+	hosts := []string{}
+	for i := 1; i <= 10; i++ {
+		k := prefix + strconv.Itoa(i)
+		if d.HasChange(k) {
+			changed = true
+		}
+		// the unused trusted hosts are 0.0.0.0 0.0.0.0 and ::/0
+		if v, ok := d.Get(k).(string); ok {
+			if ones, _ := lockoutParseAddress(v).Mask.Size(); ones > 0 {
+				hosts = append(hosts, v)
+			}
+		}
+	}
+
+	if changed && !lockoutTrusted(t.source, hosts) {
+		return fmt.Errorf("the trusted hosts of administrator %s exclude %s", auth.Username, t.source)
+	}
+
+	return nil
+}
+
+// lockoutCheckSystemApiUser refuses the trusted hosts of an API user that
+// allow the provider now and would not after the change, the API user behind
+// the token can't be determined
+func lockoutCheckSystemApiUser(d *schema.ResourceDiff, f *FortiClient, t *managementTarget) error {
+	auth := f.Client.Config.Auth
+	if t.source == nil || auth == nil || auth.PassAuth == "enable" || d.Id() == "" || !d.HasChange("trusthost") {
+		return nil
+	}
+
+	key := "ipv4_trusthost"
+	if t.source.To4() == nil {
+		key = "ipv6_trusthost"
+	}
+
+	hosts := func(v interface{}) []string {
+		var hs []string
+		l, _ := v.([]interface{})
+		for _, i := range l {
+			if e, ok := i.(map[string]interface{}); ok {
+				if h, ok := e[key].(string); ok {
+					hs = append(hs, h)
+				}
+			}
+		}
+		return hs
+	}
+
+	o, n := d.GetChange("trusthost")
+	if lockoutTrusted(t.source, hosts(o)) && !lockoutTrusted(t.source, hosts(n)) {
+		return fmt.Errorf("the trusted hosts of API user %s exclude %s", d.Id(), t.source)
+	}
+
+	return nil
+}
+
+func lockoutCheckSystemGlobal(d *schema.ResourceDiff, f *FortiClient, t *managementTarget) error {
+	if !d.HasChange("admin_sport") || !d.NewValueKnown("admin_sport") {
+		return nil
+	}
+
+	if v, ok := d.Get("admin_sport").(int); ok && v != 0 && v != t.port {
+		return fmt.Errorf("the HTTPS administrative port would change from %d to %d", t.port, v)
+	}
+
+	return nil
+}
+
+// lockoutLocalInPolicy is a local-in policy as seen by the lockout checks
+type lockoutLocalInPolicy struct {
+	id            string
+	enabled       bool
+	deny          bool
+	intf          []string
+	srcaddr       []string
+	srcaddrNegate bool
+	dstaddr       []string
+	dstaddrNegate bool
+	service       []string
+	serviceNegate bool
+}
+
+// lockoutNames returns the names of the FortiOS sub-table v, a list of
+// {"name": ...}, or of the string v
+func lockoutNames(v interface{}) []string {
+	if s, ok := v.(string); ok {
+		return strings.Fields(s)
+	}
+
+	var ns []string
+	l, _ := v.([]interface{})
+	for _, i := range l {
+		if e, ok := i.(map[string]interface{}); ok {
+			if n, ok := e["name"].(string); ok {
+				ns = append(ns, n)
+			}
+		}
+	}
+
+	return ns
+}
+
+// lockoutLocalInPolicyFromObject converts the local-in policy o read from
+// FortiOS
+func lockoutLocalInPolicyFromObject(o map[string]interface{}) lockoutLocalInPolicy {
+	return lockoutLocalInPolicy{
+		id:            fmt.Sprintf("%v", o["policyid"]),
+		enabled:       o["status"] != "disable",
+		deny:          o["action"] != "accept",
+		intf:          lockoutNames(o["intf"]),
+		srcaddr:       lockoutNames(o["srcaddr"]),
+		srcaddrNegate: o["srcaddr-negate"] == "enable",
+		dstaddr:       lockoutNames(o["dstaddr"]),
+		dstaddrNegate: o["dstaddr-negate"] == "enable",
+		service:       lockoutNames(o["service"]),
+		serviceNegate: o["service-negate"] == "enable",
+	}
+}
+
+// lockoutLocalInPolicyFromDiff converts the planned local-in policy d
+func lockoutLocalInPolicyFromDiff(d *schema.ResourceDiff) lockoutLocalInPolicy {
+	id := d.Id()
+	if id == "" {
+		if v, _ := d.Get("policyid").(int); v != 0 {
+			id = strconv.Itoa(v)
+		}
+	}
+
+	return lockoutLocalInPolicy{
+		id:            id,
+		enabled:       d.Get("status") != "disable",
+		deny:          d.Get("action") != "accept",
+		intf:          lockoutNames(d.Get("intf")),
+		srcaddr:       lockoutNames(d.Get("srcaddr")),
+		srcaddrNegate: d.Get("srcaddr_negate") == "enable",
+		dstaddr:       lockoutNames(d.Get("dstaddr")),
+		dstaddrNegate: d.Get("dstaddr_negate") == "enable",
+		service:       lockoutNames(d.Get("service")),
+		serviceNegate: d.Get("service_negate") == "enable",
+	}
+}
+
+// lockoutLocalInDenies reports whether the first of the enabled policies
+// matching the traffic is a deny policy, the policies are evaluated in order
+// and the traffic matching none is accepted
+func lockoutLocalInDenies(policies []lockoutLocalInPolicy, match func(lockoutLocalInPolicy) bool) bool {
+	for _, p := range policies {
+		if p.enabled && match(p) {
+			return p.deny
+		}
+	}
+
+	return false
+}
+
+func lockoutCheckFirewallLocalInPolicy(d *schema.ResourceDiff, f *FortiClient, t *managementTarget) error {
+	if t.source == nil || t.source.To4() == nil {
+		return nil
+	}
+
+	vdomparam, _ := d.Get("vdomparam").(string)
+	c := f.Client
+
+	var before []lockoutLocalInPolicy
+	err := f.GenericGroupReadEach("/api/v2/cmdb/firewall/local-in-policy", "", vdomparam, func(o map[string]interface{}) error {
+		before = append(before, lockoutLocalInPolicyFromObject(o))
+		return nil
+	})
+	if err != nil {
+		log.Printf("[WARN] cannot read the local-in policies, the management lockout check is skipped: %v", err)
+		return nil
+	}
+
+	// the planned policy replaces the current one, the new policies are
+	// added at the end
+	planned := lockoutLocalInPolicyFromDiff(d)
+	after := make([]lockoutLocalInPolicy, 0, len(before)+1)
+	found := false
+	for _, p := range before {
+		if d.Id() != "" && p.id == d.Id() {
+			p, found = planned, true
+		}
+		after = append(after, p)
+	}
+	if !found {
+		after = append(after, planned)
+	}
+
+	addrs := make(map[string]bool)
+	services := make(map[string]bool)
+	cached := func(cache map[string]bool, k string, fn func() bool) bool {
+		if v, ok := cache[k]; ok {
+			return v
+		}
+		cache[k] = fn()
+		return cache[k]
+	}
+
+	matchNames := func(names []string, negate bool, fn func(string) bool) bool {
+		m := false
+		for _, n := range names {
+			if fn(n) {
+				m = true
+				break
+			}
+		}
+		return m != negate
+	}
+
+	match := func(p lockoutLocalInPolicy) bool {
+		intf := false
+		for _, i := range p.intf {
+			if i == "any" || t.intf != "" && i == t.intf {
+				intf = true
+			}
+		}
+
+		return intf &&
+			matchNames(p.srcaddr, p.srcaddrNegate, func(n string) bool {
+				return cached(addrs, "src/"+n, func() bool { return lockoutAddressMatch(c, n, t.source, vdomparam, 0) })
+			}) &&
+			matchNames(p.dstaddr, p.dstaddrNegate, func(n string) bool {
+				return cached(addrs, "dst/"+n, func() bool { return lockoutAddressMatch(c, n, t.host, vdomparam, 0) })
+			}) &&
+			matchNames(p.service, p.serviceNegate, func(n string) bool {
+				return cached(services, n, func() bool { return lockoutServiceMatch(c, n, t.port, vdomparam, 0) })
+			})
+	}
+
+	// the traffic of the provider is accepted now, a deny found before the
+	// change means the policies are not understood
+	if lockoutLocalInDenies(after, match) && !lockoutLocalInDenies(before, match) {
+		return fmt.Errorf("the local-in policies would deny HTTPS from %s to %s", t.source, t.host)
+	}
+
+	return nil
+}
+
+// lockoutAddressMatch reports whether the firewall address or address group
+// name contains ip, the addresses other than subnets and ranges never match
+func lockoutAddressMatch(c *forticlient.FortiSDKClient, name string, ip net.IP, vdomparam string, depth int) bool {
+	if name == "all" {
+		return true
+	}
+	if depth > 8 {
+		return false
+	}
+
+	if o, err := cmdbRead(c, "firewall/address", []string{name}, vdomparam); err == nil && o != nil {
+		switch o["type"] {
+		case "ipmask", nil:
+			subnet, _ := o["subnet"].(string)
+			return lockoutParseAddress(subnet).Contains(ip)
+		case "iprange":
+			start, _ := o["start-ip"].(string)
+			end, _ := o["end-ip"].(string)
+			return lockoutInRange(ip, net.ParseIP(start), net.ParseIP(end))
+		}
+		return false
+	}
+
+	if o, err := cmdbRead(c, "firewall/addrgrp", []string{name}, vdomparam); err == nil && o != nil {
+		l, _ := o["member"].([]interface{})
+		for _, i := range l {
+			if e, ok := i.(map[string]interface{}); ok {
+				if n, ok := e["name"].(string); ok && lockoutAddressMatch(c, n, ip, vdomparam, depth+1) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// lockoutServiceMatch reports whether the firewall service or service group
+// name includes the TCP port port
+func lockoutServiceMatch(c *forticlient.FortiSDKClient, name string, port int, vdomparam string, depth int) bool {
+	if depth > 8 {
+		return false
+	}
+
+	if o, err := cmdbRead(c, "firewall.service/custom", []string{name}, vdomparam); err == nil && o != nil {
+		switch o["protocol"] {
+		case "IP":
+			n, _ := o["protocol-number"].(float64)
+			return n == 0 || n == 6
+		case "TCP/UDP/SCTP", "TCP/UDP/UDP-Lite/SCTP":
+			ranges, _ := o["tcp-portrange"].(string)
+			return lockoutInPortRanges(port, ranges)
+		}
+		return false
+	}
+
+	if o, err := cmdbRead(c, "firewall.service/group", []string{name}, vdomparam); err == nil && o != nil {
+		l, _ := o["member"].([]interface{})
+		for _, i := range l {
+			if e, ok := i.(map[string]interface{}); ok {
+				if n, ok := e["name"].(string); ok && lockoutServiceMatch(c, n, port, vdomparam, depth+1) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	return name == "ALL" || name == "ALL_TCP" || name == "HTTPS" && port == 443
+}
+
+func lockoutInRange(ip, start, end net.IP) bool {
+	ip, start, end = ip.To4(), start.To4(), end.To4()
+	if ip == nil || start == nil || end == nil {
+		return false
+	}
+
+	v := binary.BigEndian.Uint32(ip)
+
+	return v >= binary.BigEndian.Uint32(start) && v <= binary.BigEndian.Uint32(end)
+}
+
+// lockoutInPortRanges reports whether port is one of the destination ports of
+// the FortiOS port ranges "low[-high][:src_low[-src_high]] ..."
+func lockoutInPortRanges(port int, ranges string) bool {
+	for _, r := range strings.Fields(ranges) {
+		dst := strings.SplitN(r, ":", 2)[0]
+		bounds := strings.SplitN(dst, "-", 2)
+
+		low, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			continue
+		}
+		high := low
+		if len(bounds) == 2 {
+			if high, err = strconv.Atoi(bounds[1]); err != nil {
+				continue
+			}
+		}
+
+		if port >= low && port <= high {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,120 @@
+package fortios
+
+import (
+	"net"
+	"testing"
+)
+
+func TestLockoutParseAddress(t *testing.T) {
+	cases := []struct {
+		in   string
+		ip   string
+		ones int
+		bits int
+	}{
+		{"10.1.1.1 255.255.255.0", "10.1.1.1", 24, 32},
+		{"10.1.1.0/24", "10.1.1.0", 24, 32},
+		{" 10.1.1.5/32 ", "10.1.1.5", 32, 32},
+		{"10.1.1.1", "10.1.1.1", 32, 32},
+		{"0.0.0.0 0.0.0.0", "0.0.0.0", 0, 32},
+		{"2001:db8::1/64", "2001:db8::1", 64, 128},
+		{"2001:db8::1", "2001:db8::1", 128, 128},
+		{"", "", 0, 0},
+		{"host.example.com", "", 0, 0},
+	}
+
+	for _, c := range cases {
+		n := lockoutParseAddress(c.in)
+		if c.ip == "" {
+			if n.IP != nil {
+				t.Errorf("lockoutParseAddress(%q) = %v, want an empty network", c.in, n)
+			}
+			continue
+		}
+
+		if !n.IP.Equal(net.ParseIP(c.ip)) {
+			t.Errorf("lockoutParseAddress(%q).IP = %v, want %s", c.in, n.IP, c.ip)
+		}
+		if ones, bits := n.Mask.Size(); ones != c.ones || bits != c.bits {
+			t.Errorf("lockoutParseAddress(%q).Mask = /%d of %d, want /%d of %d", c.in, ones, bits, c.ones, c.bits)
+		}
+	}
+}
+
+func TestLockoutInPortRanges(t *testing.T) {
+	cases := []struct {
+		port   int
+		ranges string
+		want   bool
+	}{
+		{443, "443", true},
+		{443, "80 443", true},
+		{443, "400-500", true},
+		{443, "1-442 444-65535", false},
+		{443, "80:443", false},
+		{443, "443:1024-65535", true},
+		{8443, "443", false},
+		{443, "", false},
+		{443, "https 443", true},
+		{443, "500-400", false},
+	}
+
+	for _, c := range cases {
+		if got := lockoutInPortRanges(c.port, c.ranges); got != c.want {
+			t.Errorf("lockoutInPortRanges(%d, %q) = %v, want %v", c.port, c.ranges, got, c.want)
+		}
+	}
+}
+
+func TestLockoutTrusted(t *testing.T) {
+	cases := []struct {
+		ip    string
+		hosts []string
+		want  bool
+	}{
+		{"10.1.1.5", nil, true},
+		{"10.1.1.5", []string{}, true},
+		{"10.1.1.5", []string{"10.1.1.0 255.255.255.0"}, true},
+		{"10.1.1.5", []string{"10.2.0.0/16"}, false},
+		{"10.1.1.5", []string{"10.2.0.0/16", "10.1.1.5"}, true},
+		{"10.1.1.5", []string{"invalid"}, true},
+		{"10.1.1.5", []string{"invalid", "10.2.0.0/16"}, false},
+		{"2001:db8::5", []string{"2001:db8::/64"}, true},
+		{"2001:db8:1::5", []string{"2001:db8::/64"}, false},
+	}
+
+	for _, c := range cases {
+		if got := lockoutTrusted(net.ParseIP(c.ip), c.hosts); got != c.want {
+			t.Errorf("lockoutTrusted(%s, %q) = %v, want %v", c.ip, c.hosts, got, c.want)
+		}
+	}
+}
+
+func TestLockoutLocalInDenies(t *testing.T) {
+	match := func(p lockoutLocalInPolicy) bool {
+		return p.srcaddr[0] == "all" || p.srcaddr[0] == "admin"
+	}
+
+	policy := func(id string, enabled, deny bool, src string) lockoutLocalInPolicy {
+		return lockoutLocalInPolicy{id: id, enabled: enabled, deny: deny, srcaddr: []string{src}}
+	}
+
+	cases := []struct {
+		name     string
+		policies []lockoutLocalInPolicy
+		want     bool
+	}{
+		{"no policy", nil, false},
+		{"deny", []lockoutLocalInPolicy{policy("1", true, true, "all")}, true},
+		{"accept then deny", []lockoutLocalInPolicy{policy("1", true, false, "admin"), policy("2", true, true, "all")}, false},
+		{"deny then accept", []lockoutLocalInPolicy{policy("1", true, true, "all"), policy("2", true, false, "admin")}, true},
+		{"disabled accept then deny", []lockoutLocalInPolicy{policy("1", false, false, "admin"), policy("2", true, true, "all")}, true},
+		{"other deny", []lockoutLocalInPolicy{policy("1", true, true, "guests")}, false},
+	}
+
+	for _, c := range cases {
+		if got := lockoutLocalInDenies(c.policies, match); got != c.want {
+			t.Errorf("%s: lockoutLocalInDenies() = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
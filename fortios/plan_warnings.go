@@ -0,0 +1,76 @@
+package fortios
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The CustomizeDiff functions can only fail the plan, the warnings they report
+// with addPlanWarning are added to the diagnostics of the plan by the server
+// returned by ProviderServer.
+
+type planWarningsKey struct{}
+
+// planWarnings collects the warnings of the plan of a resource
+type planWarnings struct {
+	sync.Mutex
+	l []*tfprotov5.Diagnostic
+}
+
+// addPlanWarning reports a warning with the plan of the resource whose
+// CustomizeDiff runs under ctx, the warning is only logged when the provider
+// is not served by ProviderServer
+func addPlanWarning(ctx context.Context, summary, detail string) {
+	w, ok := ctx.Value(planWarningsKey{}).(*planWarnings)
+	if !ok {
+		log.Printf("[WARN] %s: %s", summary, detail)
+		return
+	}
+
+	w.Lock()
+	defer w.Unlock()
+
+	// CustomizeDiff can run twice for the same plan
+	for _, i := range w.l {
+		if i.Summary == summary && i.Detail == detail {
+			return
+		}
+	}
+
+	w.l = append(w.l, &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityWarning,
+		Summary:  summary,
+		Detail:   detail,
+	})
+}
+
+type providerServer struct {
+	tfprotov5.ProviderServer
+}
+
+// ProviderServer returns the server of the provider, which adds the warnings
+// reported by addPlanWarning to the plans
+func ProviderServer() tfprotov5.ProviderServer {
+	return providerServer{schema.NewGRPCProviderServer(Provider())}
+}
+
+func (s providerServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	w := &planWarnings{}
+
+	// the private state of the provider is kept as is by the plans
+	r := *req
+	var p *privateState
+	r.PriorPrivate, p = splitPrivateState(req.PriorPrivate)
+
+	resp, err := s.ProviderServer.PlanResourceChange(context.WithValue(ctx, planWarningsKey{}, w), &r)
+	if resp != nil {
+		resp.Diagnostics = append(resp.Diagnostics, w.l...)
+		resp.PlannedPrivate = joinPrivateState(resp.PlannedPrivate, p)
+	}
+
+	return resp, err
+}
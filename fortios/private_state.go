@@ -0,0 +1,149 @@
+package fortios
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// The SDK only keeps its own keys in the private state of the resources. The
+// server returned by ProviderServer keeps the values of the provider under
+// privateStateKey: they are taken out of the private state of the requests,
+// made available to the CRUD functions with getPrivateState and added back to
+// the private state of the responses.
+
+const privateStateKey = "fortios"
+
+type privateStateCtxKey struct{}
+
+// privateState is the private state of the resource planned, applied or read
+type privateState struct {
+	sync.Mutex
+	values map[string]json.RawMessage
+}
+
+// getPrivateState returns the private state of the resource applied or read
+// under ctx, nil if the provider is not served by ProviderServer
+func getPrivateState(ctx context.Context) *privateState {
+	p, _ := ctx.Value(privateStateCtxKey{}).(*privateState)
+	return p
+}
+
+// Get decodes the value k into v, it returns false if there is none
+func (p *privateState) Get(k string, v interface{}) bool {
+	if p == nil {
+		return false
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	b, ok := p.values[k]
+	if !ok {
+		return false
+	}
+
+	return json.Unmarshal(b, v) == nil
+}
+
+// Set sets the value k to v, or removes it if v is nil
+func (p *privateState) Set(k string, v interface{}) {
+	if p == nil {
+		return
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	if v == nil {
+		delete(p.values, k)
+		return
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+	if p.values == nil {
+		p.values = make(map[string]json.RawMessage)
+	}
+	p.values[k] = b
+}
+
+// splitPrivateState returns the private state private without the values of
+// the provider, and these values
+func splitPrivateState(private []byte) ([]byte, *privateState) {
+	p := &privateState{}
+
+	var m map[string]json.RawMessage
+	if len(private) == 0 || json.Unmarshal(private, &m) != nil {
+		return private, p
+	}
+
+	if v, ok := m[privateStateKey]; ok {
+		json.Unmarshal(v, &p.values)
+		delete(m, privateStateKey)
+		if b, err := json.Marshal(m); err == nil {
+			private = b
+		}
+	}
+
+	return private, p
+}
+
+// joinPrivateState adds the values of the provider p to the private state
+// private
+func joinPrivateState(private []byte, p *privateState) []byte {
+	p.Lock()
+	defer p.Unlock()
+
+	if len(p.values) == 0 {
+		return private
+	}
+
+	m := make(map[string]json.RawMessage)
+	if len(private) != 0 && json.Unmarshal(private, &m) != nil {
+		return private
+	}
+
+	v, err := json.Marshal(p.values)
+	if err != nil {
+		return private
+	}
+	m[privateStateKey] = v
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		return private
+	}
+
+	return b
+}
+
+func (s providerServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	r := *req
+	var p *privateState
+	r.Private, p = splitPrivateState(req.Private)
+
+	resp, err := s.ProviderServer.ReadResource(context.WithValue(ctx, privateStateCtxKey{}, p), &r)
+	if resp != nil {
+		resp.Private = joinPrivateState(resp.Private, p)
+	}
+
+	return resp, err
+}
+
+func (s providerServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	r := *req
+	var p *privateState
+	r.PlannedPrivate, p = splitPrivateState(req.PlannedPrivate)
+
+	resp, err := s.ProviderServer.ApplyResourceChange(context.WithValue(ctx, privateStateCtxKey{}, p), &r)
+	if resp != nil {
+		resp.Private = joinPrivateState(resp.Private, p)
+	}
+
+	return resp, err
+}
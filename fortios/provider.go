@@ -9,12 +9,15 @@
 package fortios
 
 import (
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // Provider creates and returns the FortiOS *schema.Provider.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"hostname": &schema.Schema{
 				Type:        schema.TypeString,
@@ -113,6 +116,72 @@ func Provider() *schema.Provider {
 				Description: "FortiGate Password",
 			},
 
+			"list_page_size": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultListPageSize,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of entries requested per page when the data sources read a whole table, 0 means no paging",
+			},
+
+			"schema_validation": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enable/disable the validation of the resources against the CMDB schema of the FortiGate during plan",
+			},
+
+			"unsupported_attributes": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "warning",
+				ValidateFunc: validation.StringInSlice([]string{"error", "warning", "disable"}, false),
+				Description:  "Action on the arguments missing from the CMDB schema of the FortiGate during plan, can be 'error', 'warning' or 'disable'",
+			},
+
+			"version_check": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "warning",
+				ValidateFunc: validation.StringInSlice([]string{"error", "warning", "disable"}, false),
+				Description:  "Action on the resources and attributes not supported by the FortiOS version of the FortiGate, can be 'error', 'warning' or 'disable'",
+			},
+
+			"allow_management_lockout": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Allow the changes that would remove the access of the provider to the FortiGate",
+			},
+
+			"config_revert_timeout": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: intBetweenWithZero(10, 2147483647),
+				Description:  "Time in seconds after which the FortiGate reverts the changes by rebooting if the provider can't reach it anymore, 0 to save the changes immediately",
+			},
+
+			"ha_sync_timeout": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Time in seconds each change waits for the members of an HA cluster to synchronize their configuration, 0 not to wait",
+			},
+			"full_update_resources": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Resources whose updates send the whole object instead of the changed attributes only",
+			},
+			"overwrite_concurrent_changes": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to apply the changes to the objects modified outside Terraform since plan",
+			},
+
 			"fmg_hostname": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -134,6 +203,14 @@ func Provider() *schema.Provider {
 				Description: "",
 			},
 
+			"fmg_token": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Default:     "",
+				Description: "REST API administrator key of the FortiManager, used instead of fmg_username and fmg_passwd",
+			},
+
 			"fmg_insecure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -146,6 +223,35 @@ func Provider() *schema.Provider {
 				Default:     "",
 				Description: "CA Bundle file",
 			},
+
+			"fmg_session_cache": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "File keeping the FortiManager sessions between the runs of the provider",
+			},
+
+			"fmg_max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultFMGMaxConcurrentRequests,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum number of JSON-RPC calls sent to the FortiManager at the same time",
+			},
+
+			"fmg_proxy_target": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Name of the FortiGate managed by the FortiManager the FortiOS resources are sent to through the FortiManager, instead of hostname",
+			},
+
+			"fmg_proxy_adom": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "root",
+				Description: "ADOM of the FortiGate fmg_proxy_target",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -222,6 +328,7 @@ func Provider() *schema.Provider {
 			"fortios_system_automationaction":                 dataSourceSystemAutomationAction(),
 			"fortios_system_automationdestination":            dataSourceSystemAutomationDestination(),
 			"fortios_system_automationtrigger":                dataSourceSystemAutomationTrigger(),
+			"fortios_system_capabilities":                     dataSourceSystemCapabilities(),
 			"fortios_system_centralmanagement":                dataSourceSystemCentralManagement(),
 			"fortios_system_clustersync":                      dataSourceSystemClusterSync(),
 			"fortios_system_console":                          dataSourceSystemConsole(),
@@ -380,6 +487,12 @@ func Provider() *schema.Provider {
 			"fortios_systemsnmp_userlist":                     dataSourceSystemSnmpUserList(),
 			"fortios_user_samllist":                           dataSourceUserSamlList(),
 			"fortios_routerbgp_neighborlist":                  dataSourceRouterbgpNeighborList(),
+			"fortios_fmg_devicemanager_install_preview":       dataSourceFortimanagerDVMInstallPreview(),
+			"fortios_fmg_adoms":                               dataSourceFortimanagerAdoms(),
+			"fortios_fmg_devicemanager_devices":               dataSourceFortimanagerDVMDevices(),
+			"fortios_fmg_firewall_security_policypackages":    dataSourceFortimanagerFirewallSecurityPolicyPackages(),
+			"fortios_fmg_adom_objects":                        dataSourceFortimanagerAdomObjects(),
+			"fortios_fmg_tasks":                               dataSourceFortimanagerTasks(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -411,6 +524,13 @@ func Provider() *schema.Provider {
 			"fortios_vpn_ipsec_phase1interface":               resourceVPNIPsecPhase1Interface(),
 			"fortios_vpn_ipsec_phase2interface":               resourceVPNIPsecPhase2Interface(),
 			"fortios_json_generic_api":                        resourceJSONGenericAPI(),
+			"fortios_cmdb_object":                             resourceCmdbObject(),
+			"fortios_cmdb_subtable_entry":                     resourceCmdbSubtableEntry(),
+			"fortios_firewall_addrgrp_member":                 resourceGroupMember("firewall/addrgrp", "FirewallAddrgrpMember"),
+			"fortios_firewall_addrgrp6_member":                resourceGroupMember("firewall/addrgrp6", "FirewallAddrgrp6Member"),
+			"fortios_firewall_vipgrp_member":                  resourceGroupMember("firewall/vipgrp", "FirewallVipgrpMember"),
+			"fortios_firewallservice_group_member":            resourceGroupMember("firewall.service/group", "FirewallServiceGroupMember"),
+			"fortios_user_group_member":                       resourceGroupMember("user/group", "UserGroupMember"),
 			"fortios_fmg_system_admin_profiles":               resourceFortimanagerSystemAdminProfiles(),
 			"fortios_fmg_system_admin_user":                   resourceFortimanagerSystemAdminUser(),
 			"fortios_fmg_devicemanager_device":                resourceFortimanagerDVMDevice(),
@@ -436,6 +556,17 @@ func Provider() *schema.Provider {
 			"fortios_fmg_system_license_vm":                   resourceFortimanagerSystemLicenseVM(),
 			"fortios_fmg_system_license_forticare":            resourceFortimanagerSystemLicenseFortiCare(),
 			"fortios_fmg_jsonrpc_request":                     resourceFortimanagerJSONRPCRequest(),
+			"fortios_fmg_firewall_object_addressgroup":        resourceFortimanagerFirewallObjectAddressGroup(),
+			"fortios_fmg_firewall_object_servicegroup":        resourceFortimanagerFirewallObjectServiceGroup(),
+			"fortios_fmg_firewall_object_vipgroup":            resourceFortimanagerFirewallObjectVipGroup(),
+			"fortios_fmg_firewall_object_schedule_onetime":    resourceFortimanagerFirewallObjectScheduleOnetime(),
+			"fortios_fmg_firewall_object_schedule_recurring":  resourceFortimanagerFirewallObjectScheduleRecurring(),
+			"fortios_fmg_firewall_object_profilegroup":        resourceFortimanagerFirewallObjectProfileGroup(),
+			"fortios_fmg_securityprofile":                     resourceFortimanagerSecurityProfile(),
+			"fortios_fmg_object_zone":                         resourceFortimanagerObjectZone(),
+			"fortios_fmg_object_metadata_variable":            resourceFortimanagerObjectMetadataVariable(),
+			"fortios_fmg_object_metadata_variable_mapping":    resourceFortimanagerObjectMetadataVariableMapping(),
+			"fortios_fmg_object_cli_template_assignment":      resourceFortimanagerObjectCLITemplateAssignment(),
 
 			"fortios_alertemail_setting":                                 resourceAlertemailSetting(),
 			"fortios_antivirus_heuristic":                                resourceAntivirusHeuristic(),
@@ -1040,6 +1171,24 @@ func Provider() *schema.Provider {
 
 		ConfigureFunc: providerConfigure,
 	}
+
+	for name, r := range p.ResourcesMap {
+		t, cmdb := cmdbResources[name]
+		if cmdb {
+			configureCmdbResource(name, r, t)
+		}
+		if !strings.HasPrefix(name, "fortios_fmg_") {
+			configureConfigRevert(r)
+			configureHASync(r)
+		} else {
+			configureFMGWorkspace(name, r)
+		}
+		if cmdb {
+			configureCmdbSecrets(r, t)
+		}
+	}
+
+	return p
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
@@ -1060,10 +1209,31 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		FMG_Username:    d.Get("fmg_username").(string),
 		FMG_Passwd:      d.Get("fmg_passwd").(string),
 
+		FMG_SessionCache: d.Get("fmg_session_cache").(string),
+		FMG_Token:        d.Get("fmg_token").(string),
+
+		FMG_MaxConcurrentRequests: d.Get("fmg_max_concurrent_requests").(int),
+
+		FMG_ProxyTarget: d.Get("fmg_proxy_target").(string),
+		FMG_ProxyAdom:   d.Get("fmg_proxy_adom").(string),
+
 		PeerAuth:   d.Get("peerauth").(string),
 		CaCert:     d.Get("cacert").(string),
 		ClientCert: d.Get("clientcert").(string),
 		ClientKey:  d.Get("clientkey").(string),
+
+		ListPageSize:               d.Get("list_page_size").(int),
+		SchemaValidation:           d.Get("schema_validation").(bool),
+		UnsupportedAttributes:      d.Get("unsupported_attributes").(string),
+		VersionCheck:               d.Get("version_check").(string),
+		AllowManagementLockout:     d.Get("allow_management_lockout").(bool),
+		ConfigRevertTimeout:        d.Get("config_revert_timeout").(int),
+		HASyncTimeout:              d.Get("ha_sync_timeout").(int),
+		OverwriteConcurrentChanges: d.Get("overwrite_concurrent_changes").(bool),
+	}
+
+	for _, r := range d.Get("full_update_resources").(*schema.Set).List() {
+		config.FullUpdateResources = append(config.FullUpdateResources, r.(string))
 	}
 
 	v1, ok1 := d.GetOkExists("insecure")
@@ -252,6 +252,8 @@ func resourceAlertemailSettingUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating AlertemailSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_alertemail_setting", obj)
+
 	o, err := c.UpdateAlertemailSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating AlertemailSetting resource: %v", err)
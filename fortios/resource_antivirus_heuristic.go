@@ -62,6 +62,8 @@ func resourceAntivirusHeuristicUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating AntivirusHeuristic resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_antivirus_heuristic", obj)
+
 	o, err := c.UpdateAntivirusHeuristic(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating AntivirusHeuristic resource: %v", err)
@@ -1067,6 +1067,8 @@ func resourceAntivirusProfileUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating AntivirusProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_antivirus_profile", obj)
+
 	o, err := c.UpdateAntivirusProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating AntivirusProfile resource: %v", err)
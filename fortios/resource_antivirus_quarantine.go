@@ -124,6 +124,8 @@ func resourceAntivirusQuarantineUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating AntivirusQuarantine resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_antivirus_quarantine", obj)
+
 	o, err := c.UpdateAntivirusQuarantine(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating AntivirusQuarantine resource: %v", err)
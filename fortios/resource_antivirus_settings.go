@@ -93,6 +93,8 @@ func resourceAntivirusSettingsUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating AntivirusSettings resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_antivirus_settings", obj)
+
 	o, err := c.UpdateAntivirusSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating AntivirusSettings resource: %v", err)
@@ -143,6 +143,8 @@ func resourceApplicationCustomUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating ApplicationCustom resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_application_custom", obj)
+
 	o, err := c.UpdateApplicationCustom(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ApplicationCustom resource: %v", err)
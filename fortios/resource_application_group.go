@@ -175,6 +175,8 @@ func resourceApplicationGroupUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating ApplicationGroup resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_application_group", obj)
+
 	o, err := c.UpdateApplicationGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ApplicationGroup resource: %v", err)
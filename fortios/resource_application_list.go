@@ -427,6 +427,8 @@ func resourceApplicationListUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating ApplicationList resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_application_list", obj)
+
 	o, err := c.UpdateApplicationList(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ApplicationList resource: %v", err)
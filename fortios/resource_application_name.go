@@ -197,6 +197,8 @@ func resourceApplicationNameUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating ApplicationName resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_application_name", obj)
+
 	o, err := c.UpdateApplicationName(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ApplicationName resource: %v", err)
@@ -94,6 +94,8 @@ func resourceApplicationRuleSettingsUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating ApplicationRuleSettings resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_application_rulesettings", obj)
+
 	o, err := c.UpdateApplicationRuleSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ApplicationRuleSettings resource: %v", err)
@@ -218,6 +218,8 @@ func resourceAuthenticationRuleUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating AuthenticationRule resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_authentication_rule", obj)
+
 	o, err := c.UpdateAuthenticationRule(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating AuthenticationRule resource: %v", err)
@@ -175,6 +175,8 @@ func resourceAuthenticationSchemeUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating AuthenticationScheme resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_authentication_scheme", obj)
+
 	o, err := c.UpdateAuthenticationScheme(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating AuthenticationScheme resource: %v", err)
@@ -195,6 +195,8 @@ func resourceAuthenticationSettingUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating AuthenticationSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_authentication_setting", obj)
+
 	o, err := c.UpdateAuthenticationSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating AuthenticationSetting resource: %v", err)
@@ -63,6 +63,8 @@ func resourceAutomationSettingUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating AutomationSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_automation_setting", obj)
+
 	o, err := c.UpdateAutomationSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating AutomationSetting resource: %v", err)
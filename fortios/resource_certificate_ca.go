@@ -156,6 +156,8 @@ func resourceCertificateCaUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating CertificateCa resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_certificate_ca", obj)
+
 	o, err := c.UpdateCertificateCa(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating CertificateCa resource: %v", err)
@@ -167,6 +167,8 @@ func resourceCertificateCrlUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating CertificateCrl resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_certificate_crl", obj)
+
 	o, err := c.UpdateCertificateCrl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating CertificateCrl resource: %v", err)
@@ -254,6 +254,8 @@ func resourceCertificateLocalUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating CertificateLocal resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_certificate_local", obj)
+
 	o, err := c.UpdateCertificateLocal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating CertificateLocal resource: %v", err)
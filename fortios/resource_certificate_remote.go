@@ -111,6 +111,8 @@ func resourceCertificateRemoteUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating CertificateRemote resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_certificate_remote", obj)
+
 	o, err := c.UpdateCertificateRemote(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating CertificateRemote resource: %v", err)
@@ -130,6 +130,8 @@ func resourceCifsDomainControllerUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating CifsDomainController resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_cifs_domaincontroller", obj)
+
 	o, err := c.UpdateCifsDomainController(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating CifsDomainController resource: %v", err)
@@ -195,6 +195,8 @@ func resourceCifsProfileUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating CifsProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_cifs_profile", obj)
+
 	o, err := c.UpdateCifsProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating CifsProfile resource: %v", err)
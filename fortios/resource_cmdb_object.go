@@ -0,0 +1,301 @@
+package fortios
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCmdbObject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCmdbObjectCreate,
+		Read:   resourceCmdbObjectRead,
+		Update: resourceCmdbObjectUpdate,
+		Delete: resourceCmdbObjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceCmdbObjectImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vdomparam": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"path": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCmdbPath,
+			},
+			"mkey": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"attributes": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+		},
+	}
+}
+
+func validateCmdbPath(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if len(strings.Split(strings.Trim(v, "/"), "/")) != 2 {
+		errors = append(errors, fmt.Errorf("expected %s to be a CMDB table path such as \"firewall/address\", got %s", k, v))
+	}
+
+	return
+}
+
+func resourceCmdbObjectCreate(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	path := strings.Trim(d.Get("path").(string), "/")
+	mkey := d.Get("mkey").(string)
+
+	obj, err := structure.ExpandJsonFromString(d.Get("attributes").(string))
+	if err != nil {
+		return fmt.Errorf("Error creating CmdbObject resource while getting object: %v", err)
+	}
+
+	ts, err := cmdbReadSchema(c, path, vdomparam)
+	if err != nil {
+		log.Printf("[WARN] cannot read the schema of %s, assuming it is a table: %v", path, err)
+		ts = &cmdbTableSchema{Category: "table"}
+	}
+
+	if ts.isSingleton() {
+		_, err = cmdbCreateUpdate(c, "PUT", path, nil, obj, vdomparam)
+		if err != nil {
+			return fmt.Errorf("Error creating CmdbObject resource: %v", err)
+		}
+
+		d.SetId(path)
+
+		return resourceCmdbObjectRead(d, m)
+	}
+
+	if mkey != "" && ts.Mkey != "" {
+		if _, ok := obj[ts.Mkey]; !ok {
+			obj[ts.Mkey] = cmdbMkeyValue(mkey, ts.MkeyType)
+		}
+	}
+
+	o, err := cmdbCreateUpdate(c, "POST", path, nil, obj, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error creating CmdbObject resource: %v", err)
+	}
+
+	if mkey == "" {
+		mkey = o
+	}
+	if mkey == "" {
+		return fmt.Errorf("Error creating CmdbObject resource: no mkey returned for %s, please set mkey", path)
+	}
+
+	d.SetId(path + "/" + mkey)
+	d.Set("mkey", mkey)
+
+	return resourceCmdbObjectRead(d, m)
+}
+
+func resourceCmdbObjectUpdate(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	path, mkeys := resourceCmdbObjectKey(d)
+
+	obj, err := structure.ExpandJsonFromString(d.Get("attributes").(string))
+	if err != nil {
+		return fmt.Errorf("Error updating CmdbObject resource while getting object: %v", err)
+	}
+
+	_, err = cmdbCreateUpdate(c, "PUT", path, mkeys, obj, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error updating CmdbObject resource: %v", err)
+	}
+
+	return resourceCmdbObjectRead(d, m)
+}
+
+func resourceCmdbObjectDelete(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	path, mkeys := resourceCmdbObjectKey(d)
+
+	// a singleton table can not be deleted, it is only removed from the state
+	if len(mkeys) > 0 {
+		err := cmdbDelete(c, path, mkeys, vdomparam)
+		if err != nil {
+			return fmt.Errorf("Error deleting CmdbObject resource: %v", err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCmdbObjectRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	path, mkeys := resourceCmdbObjectKey(d)
+
+	o, err := cmdbRead(c, path, mkeys, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error reading CmdbObject resource: %v", err)
+	}
+
+	if o == nil {
+		log.Printf("[WARN] resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	var want interface{}
+	if v, ok := d.GetOk("attributes"); ok {
+		json.Unmarshal([]byte(v.(string)), &want)
+	}
+
+	attrs, err := json.Marshal(cmdbFilterAttributes(want, o))
+	if err != nil {
+		return fmt.Errorf("Error reading CmdbObject resource from API: %v", err)
+	}
+
+	d.Set("path", path)
+	if len(mkeys) > 0 {
+		d.Set("mkey", mkeys[0])
+	}
+	d.Set("attributes", string(attrs))
+
+	return nil
+}
+
+func resourceCmdbObjectImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	path, mkeys := resourceCmdbObjectKey(d)
+	if len(strings.Split(path, "/")) != 2 {
+		return nil, fmt.Errorf("Error importing CmdbObject resource: the import ID should be path/mkey, such as firewall/address/myaddress, got %s", d.Id())
+	}
+
+	d.Set("path", path)
+	if len(mkeys) > 0 {
+		d.Set("mkey", mkeys[0])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceCmdbObjectKey splits the ID path/mkey into the table path and the
+// mkey, the mkey is absent for singleton tables
+func resourceCmdbObjectKey(d *schema.ResourceData) (string, []string) {
+	parts := strings.SplitN(strings.Trim(d.Id(), "/"), "/", 3)
+	if len(parts) < 3 {
+		return strings.Join(parts, "/"), nil
+	}
+
+	return parts[0] + "/" + parts[1], []string{parts[2]}
+}
+
+func cmdbMkeyValue(mkey, mkeyType string) interface{} {
+	if mkeyType == "integer" {
+		if i, err := strconv.Atoi(mkey); err == nil {
+			return i
+		}
+	}
+
+	return mkey
+}
+
+// cmdbFilterAttributes returns the parts of the object got read from FortiOS
+// that are present in want, so that only the attributes set by the user are
+// compared. Values that are equal to the wanted ones except for their JSON
+// type, such as 10 and "10", are kept as written by the user.
+func cmdbFilterAttributes(want, got interface{}) interface{} {
+	switch g := got.(type) {
+	case map[string]interface{}:
+		w, ok := want.(map[string]interface{})
+		res := make(map[string]interface{})
+		for k, v := range g {
+			if k == "q_origin_key" {
+				continue
+			}
+			if !ok {
+				res[k] = cmdbFilterAttributes(nil, v)
+			} else if wv, ok := w[k]; ok {
+				res[k] = cmdbFilterAttributes(wv, v)
+			}
+		}
+		return res
+	case []interface{}:
+		w, _ := want.([]interface{})
+		res := make([]interface{}, 0, len(g))
+		for i, v := range g {
+			var wv interface{}
+			if i < len(w) {
+				wv = w[i]
+			} else if len(w) > 0 {
+				wv = w[len(w)-1]
+			}
+			res = append(res, cmdbFilterAttributes(wv, v))
+		}
+		return res
+	default:
+		if want != nil && fmt.Sprintf("%v", want) == fmt.Sprintf("%v", got) {
+			return want
+		}
+		return got
+	}
+}
@@ -0,0 +1,100 @@
+package fortios
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFortiOSCmdbObject_basic(t *testing.T) {
+	rname := acctest.RandString(8)
+	log.Printf("TestAccFortiOSCmdbObject_basic %s", rname)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCmdbObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFortiOSCmdbObjectConfig(rname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFortiOSCmdbObjectExists("fortios_cmdb_object.trname"),
+					resource.TestCheckResourceAttr("fortios_cmdb_object.trname", "path", "firewall/address"),
+					resource.TestCheckResourceAttr("fortios_cmdb_object.trname", "mkey", rname),
+					resource.TestCheckResourceAttr("fortios_cmdb_object.trname", "id", "firewall/address/"+rname),
+				),
+			},
+			{
+				ResourceName:      "fortios_cmdb_object.trname",
+				ImportState:       true,
+				ImportStateId:     "firewall/address/" + rname,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"attributes",
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckFortiOSCmdbObjectExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found CmdbObject: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CmdbObject is set")
+		}
+
+		c := testAccProvider.Meta().(*FortiClient).Client
+
+		o, err := cmdbRead(c, rs.Primary.Attributes["path"], []string{rs.Primary.Attributes["mkey"]}, "root")
+		if err != nil {
+			return fmt.Errorf("Error reading CmdbObject: %s", err)
+		}
+
+		if o == nil {
+			return fmt.Errorf("Error creating CmdbObject: %s", n)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCmdbObjectDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*FortiClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fortios_cmdb_object" {
+			continue
+		}
+
+		o, err := cmdbRead(c, rs.Primary.Attributes["path"], []string{rs.Primary.Attributes["mkey"]}, "root")
+		if err == nil && o != nil {
+			return fmt.Errorf("Error CmdbObject %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccFortiOSCmdbObjectConfig(name string) string {
+	return fmt.Sprintf(`
+resource "fortios_cmdb_object" "trname" {
+  path = "firewall/address"
+  mkey = "%[1]s"
+
+  attributes = jsonencode({
+    type    = "ipmask"
+    subnet  = "22.1.1.0 255.255.255.0"
+    comment = "Terraform test"
+  })
+}
+`, name)
+}
@@ -0,0 +1,266 @@
+package fortios
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCmdbSubtableEntry() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCmdbSubtableEntryCreate,
+		Read:   resourceCmdbSubtableEntryRead,
+		Update: resourceCmdbSubtableEntryUpdate,
+		Delete: resourceCmdbSubtableEntryDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceCmdbSubtableEntryImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vdomparam": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"path": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCmdbPath,
+			},
+			"mkey": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringDoesNotContainAny("/"),
+			},
+			"subtable": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringDoesNotContainAny("/"),
+			},
+			"entry_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"attributes": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+		},
+	}
+}
+
+func resourceCmdbSubtableEntryCreate(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	path := strings.Trim(d.Get("path").(string), "/")
+	mkey := d.Get("mkey").(string)
+	subtable := d.Get("subtable").(string)
+	key := d.Get("entry_key").(string)
+
+	obj, err := structure.ExpandJsonFromString(d.Get("attributes").(string))
+	if err != nil {
+		return fmt.Errorf("Error creating CmdbSubtableEntry resource while getting object: %v", err)
+	}
+
+	if key != "" {
+		name, keyType := cmdbSubtableKey(c, path, subtable, vdomparam, key)
+		if _, ok := obj[name]; !ok {
+			obj[name] = cmdbMkeyValue(key, keyType)
+		}
+	}
+
+	o, err := cmdbCreateUpdate(c, "POST", path, []string{mkey, subtable}, obj, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error creating CmdbSubtableEntry resource: %v", err)
+	}
+
+	if key == "" {
+		key = o
+	}
+	if key == "" {
+		return fmt.Errorf("Error creating CmdbSubtableEntry resource: no key returned for %s/%s/%s, please set entry_key", path, mkey, subtable)
+	}
+
+	d.SetId(strings.Join([]string{path, mkey, subtable, key}, "/"))
+	d.Set("entry_key", key)
+
+	return resourceCmdbSubtableEntryRead(d, m)
+}
+
+func resourceCmdbSubtableEntryUpdate(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	path, mkeys := resourceCmdbSubtableEntryKey(d.Id())
+
+	obj, err := structure.ExpandJsonFromString(d.Get("attributes").(string))
+	if err != nil {
+		return fmt.Errorf("Error updating CmdbSubtableEntry resource while getting object: %v", err)
+	}
+
+	_, err = cmdbCreateUpdate(c, "PUT", path, mkeys, obj, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error updating CmdbSubtableEntry resource: %v", err)
+	}
+
+	return resourceCmdbSubtableEntryRead(d, m)
+}
+
+func resourceCmdbSubtableEntryDelete(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	path, mkeys := resourceCmdbSubtableEntryKey(d.Id())
+
+	err := cmdbDelete(c, path, mkeys, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error deleting CmdbSubtableEntry resource: %v", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCmdbSubtableEntryRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	path, mkeys := resourceCmdbSubtableEntryKey(d.Id())
+	if len(mkeys) != 3 {
+		return fmt.Errorf("Error reading CmdbSubtableEntry resource: invalid ID %s", d.Id())
+	}
+
+	o, err := cmdbRead(c, path, mkeys, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error reading CmdbSubtableEntry resource: %v", err)
+	}
+
+	if o == nil {
+		log.Printf("[WARN] resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	var want interface{}
+	if v, ok := d.GetOk("attributes"); ok {
+		json.Unmarshal([]byte(v.(string)), &want)
+	}
+
+	attrs, err := json.Marshal(cmdbFilterAttributes(want, o))
+	if err != nil {
+		return fmt.Errorf("Error reading CmdbSubtableEntry resource from API: %v", err)
+	}
+
+	d.Set("path", path)
+	d.Set("mkey", mkeys[0])
+	d.Set("subtable", mkeys[1])
+	d.Set("entry_key", mkeys[2])
+	d.Set("attributes", string(attrs))
+
+	return nil
+}
+
+func resourceCmdbSubtableEntryImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	vdomparam, id, _ := cmdbImportVdom(d.Id())
+
+	path, mkeys := resourceCmdbSubtableEntryKey(id)
+	if len(mkeys) != 3 {
+		return nil, fmt.Errorf("Error importing CmdbSubtableEntry resource: the import ID should be [vdom:]path/mkey/subtable/entry_key, such as system/dns-database/example/dns-entry/5, got %s", d.Id())
+	}
+
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	o, err := cmdbRead(c, path, mkeys, vdomparam)
+	if err != nil {
+		return nil, fmt.Errorf("Error importing CmdbSubtableEntry resource: %v", err)
+	}
+	if o == nil {
+		return nil, fmt.Errorf("Error importing CmdbSubtableEntry resource: %s not found", d.Id())
+	}
+
+	d.SetId(id)
+	if vdomparam != "" {
+		d.Set("vdomparam", vdomparam)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceCmdbSubtableEntryKey splits the ID path/mkey/subtable/entry_key into
+// the table path and the keys of the entry, the entry key may contain slashes
+func resourceCmdbSubtableEntryKey(id string) (string, []string) {
+	parts := strings.SplitN(strings.Trim(id, "/"), "/", 5)
+	if len(parts) < 5 {
+		return id, nil
+	}
+
+	return parts[0] + "/" + parts[1], parts[2:]
+}
+
+// cmdbSubtableKey returns the name and the type of the key of the sub-table
+// subtable of the CMDB table path, guessed from key when the schema of the
+// table can't be read
+func cmdbSubtableKey(c *forticlient.FortiSDKClient, path, subtable, vdomparam, key string) (string, string) {
+	ts, err := cmdbReadSchema(c, path, vdomparam)
+	if err != nil {
+		log.Printf("[WARN] cannot read the schema of %s: %v", path, err)
+	} else if a, ok := ts.Children[subtable]; ok && a.Mkey != "" {
+		return a.Mkey, a.MkeyType
+	}
+
+	if _, err := strconv.Atoi(key); err == nil {
+		return "id", "integer"
+	}
+
+	return "name", "string"
+}
@@ -0,0 +1,121 @@
+package fortios
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFortiOSCmdbSubtableEntry_basic(t *testing.T) {
+	rname := acctest.RandString(8)
+	log.Printf("TestAccFortiOSCmdbSubtableEntry_basic %s", rname)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCmdbSubtableEntryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFortiOSCmdbSubtableEntryConfig(rname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFortiOSCmdbSubtableEntryExists("fortios_cmdb_subtable_entry.trname"),
+					resource.TestCheckResourceAttr("fortios_cmdb_subtable_entry.trname", "path", "system/dns-database"),
+					resource.TestCheckResourceAttr("fortios_cmdb_subtable_entry.trname", "mkey", rname),
+					resource.TestCheckResourceAttr("fortios_cmdb_subtable_entry.trname", "subtable", "dns-entry"),
+					resource.TestCheckResourceAttr("fortios_cmdb_subtable_entry.trname", "entry_key", "5"),
+					resource.TestCheckResourceAttr("fortios_cmdb_subtable_entry.trname", "id", "system/dns-database/"+rname+"/dns-entry/5"),
+				),
+			},
+			{
+				ResourceName:      "fortios_cmdb_subtable_entry.trname",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"attributes",
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckFortiOSCmdbSubtableEntryExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found CmdbSubtableEntry: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CmdbSubtableEntry is set")
+		}
+
+		c := testAccProvider.Meta().(*FortiClient).Client
+
+		path, mkeys := resourceCmdbSubtableEntryKey(rs.Primary.ID)
+		o, err := cmdbRead(c, path, mkeys, "root")
+		if err != nil {
+			return fmt.Errorf("Error reading CmdbSubtableEntry: %s", err)
+		}
+
+		if o == nil {
+			return fmt.Errorf("Error creating CmdbSubtableEntry: %s", n)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCmdbSubtableEntryDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*FortiClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fortios_cmdb_subtable_entry" {
+			continue
+		}
+
+		path, mkeys := resourceCmdbSubtableEntryKey(rs.Primary.ID)
+		o, err := cmdbRead(c, path, mkeys, "root")
+		if err == nil && o != nil {
+			return fmt.Errorf("Error CmdbSubtableEntry %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccFortiOSCmdbSubtableEntryConfig(name string) string {
+	return fmt.Sprintf(`
+resource "fortios_system_dnsdatabase" "trname" {
+  authoritative = "enable"
+  domain        = "s.%[1]s.com"
+  name          = "%[1]s"
+  primary_name  = "dns"
+  status        = "enable"
+  ttl           = 86400
+  type          = "master"
+  view          = "shadow"
+
+  lifecycle {
+    ignore_changes = [dns_entry]
+  }
+}
+
+resource "fortios_cmdb_subtable_entry" "trname" {
+  path      = "system/dns-database"
+  mkey      = fortios_system_dnsdatabase.trname.name
+  subtable  = "dns-entry"
+  entry_key = "5"
+
+  attributes = jsonencode({
+    type     = "A"
+    hostname = "www"
+    ip       = "10.1.1.5"
+    status   = "enable"
+  })
+}
+`, name)
+}
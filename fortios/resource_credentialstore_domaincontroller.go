@@ -136,6 +136,8 @@ func resourceCredentialStoreDomainControllerUpdate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating CredentialStoreDomainController resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_credentialstore_domaincontroller", obj)
+
 	o, err := c.UpdateCredentialStoreDomainController(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating CredentialStoreDomainController resource: %v", err)
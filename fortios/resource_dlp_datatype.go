@@ -136,6 +136,8 @@ func resourceDlpDataTypeUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating DlpDataType resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_dlp_datatype", obj)
+
 	o, err := c.UpdateDlpDataType(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DlpDataType resource: %v", err)
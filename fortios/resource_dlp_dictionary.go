@@ -161,6 +161,8 @@ func resourceDlpDictionaryUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating DlpDictionary resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_dlp_dictionary", obj)
+
 	o, err := c.UpdateDlpDictionary(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DlpDictionary resource: %v", err)
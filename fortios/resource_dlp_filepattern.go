@@ -133,6 +133,8 @@ func resourceDlpFilepatternUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating DlpFilepattern resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_dlp_filepattern", obj)
+
 	o, err := c.UpdateDlpFilepattern(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DlpFilepattern resource: %v", err)
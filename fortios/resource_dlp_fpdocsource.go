@@ -187,6 +187,8 @@ func resourceDlpFpDocSourceUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating DlpFpDocSource resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_dlp_fpdocsource", obj)
+
 	o, err := c.UpdateDlpFpDocSource(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DlpFpDocSource resource: %v", err)
@@ -95,6 +95,8 @@ func resourceDlpFpSensitivityUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating DlpFpSensitivity resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_dlp_fpsensitivity", obj)
+
 	o, err := c.UpdateDlpFpSensitivity(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DlpFpSensitivity resource: %v", err)
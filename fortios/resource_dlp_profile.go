@@ -246,6 +246,8 @@ func resourceDlpProfileUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating DlpProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_dlp_profile", obj)
+
 	o, err := c.UpdateDlpProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DlpProfile resource: %v", err)
@@ -95,6 +95,8 @@ func resourceDlpSensitivityUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating DlpSensitivity resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_dlp_sensitivity", obj)
+
 	o, err := c.UpdateDlpSensitivity(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DlpSensitivity resource: %v", err)
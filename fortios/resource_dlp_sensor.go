@@ -303,6 +303,8 @@ func resourceDlpSensorUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating DlpSensor resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_dlp_sensor", obj)
+
 	o, err := c.UpdateDlpSensor(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DlpSensor resource: %v", err)
@@ -85,6 +85,8 @@ func resourceDlpSettingsUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating DlpSettings resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_dlp_settings", obj)
+
 	o, err := c.UpdateDlpSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DlpSettings resource: %v", err)
@@ -143,6 +143,8 @@ func resourceDnsfilterDomainFilterUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating DnsfilterDomainFilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_dnsfilter_domainfilter", obj)
+
 	o, err := c.UpdateDnsfilterDomainFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DnsfilterDomainFilter resource: %v", err)
@@ -278,6 +278,8 @@ func resourceDnsfilterProfileUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating DnsfilterProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_dnsfilter_profile", obj)
+
 	o, err := c.UpdateDnsfilterProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DnsfilterProfile resource: %v", err)
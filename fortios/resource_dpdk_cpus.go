@@ -87,6 +87,8 @@ func resourceDpdkCpusUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating DpdkCpus resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_dpdk_cpus", obj)
+
 	o, err := c.UpdateDpdkCpus(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DpdkCpus resource: %v", err)
@@ -118,6 +118,8 @@ func resourceDpdkGlobalUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating DpdkGlobal resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_dpdk_global", obj)
+
 	o, err := c.UpdateDpdkGlobal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DpdkGlobal resource: %v", err)
@@ -171,6 +171,8 @@ func resourceEmailfilterBlockAllowListUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating EmailfilterBlockAllowList resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_emailfilter_blockallowlist", obj)
+
 	o, err := c.UpdateEmailfilterBlockAllowList(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EmailfilterBlockAllowList resource: %v", err)
@@ -165,6 +165,8 @@ func resourceEmailfilterBwlUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating EmailfilterBwl resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_emailfilter_bwl", obj)
+
 	o, err := c.UpdateEmailfilterBwl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EmailfilterBwl resource: %v", err)
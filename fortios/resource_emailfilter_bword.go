@@ -161,6 +161,8 @@ func resourceEmailfilterBwordUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating EmailfilterBword resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_emailfilter_bword", obj)
+
 	o, err := c.UpdateEmailfilterBword(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EmailfilterBword resource: %v", err)
@@ -140,6 +140,8 @@ func resourceEmailfilterDnsblUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating EmailfilterDnsbl resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_emailfilter_dnsbl", obj)
+
 	o, err := c.UpdateEmailfilterDnsbl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EmailfilterDnsbl resource: %v", err)
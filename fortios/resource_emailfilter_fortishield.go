@@ -73,6 +73,8 @@ func resourceEmailfilterFortishieldUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating EmailfilterFortishield resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_emailfilter_fortishield", obj)
+
 	o, err := c.UpdateEmailfilterFortishield(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EmailfilterFortishield resource: %v", err)
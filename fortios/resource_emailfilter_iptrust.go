@@ -144,6 +144,8 @@ func resourceEmailfilterIptrustUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating EmailfilterIptrust resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_emailfilter_iptrust", obj)
+
 	o, err := c.UpdateEmailfilterIptrust(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EmailfilterIptrust resource: %v", err)
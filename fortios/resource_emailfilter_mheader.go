@@ -151,6 +151,8 @@ func resourceEmailfilterMheaderUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating EmailfilterMheader resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_emailfilter_mheader", obj)
+
 	o, err := c.UpdateEmailfilterMheader(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EmailfilterMheader resource: %v", err)
@@ -63,6 +63,8 @@ func resourceEmailfilterOptionsUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating EmailfilterOptions resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_emailfilter_options", obj)
+
 	o, err := c.UpdateEmailfilterOptions(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EmailfilterOptions resource: %v", err)
@@ -463,6 +463,8 @@ func resourceEmailfilterProfileUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating EmailfilterProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_emailfilter_profile", obj)
+
 	o, err := c.UpdateEmailfilterProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EmailfilterProfile resource: %v", err)
@@ -121,6 +121,8 @@ func resourceEndpointControlClientUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating EndpointControlClient resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_endpointcontrol_client", obj)
+
 	o, err := c.UpdateEndpointControlClient(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EndpointControlClient resource: %v", err)
@@ -238,6 +238,8 @@ func resourceEndpointControlFctemsUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating EndpointControlFctems resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_endpointcontrol_fctems", obj)
+
 	o, err := c.UpdateEndpointControlFctems(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EndpointControlFctems resource: %v", err)
@@ -145,6 +145,8 @@ func resourceEndpointControlForticlientEmsUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating EndpointControlForticlientEms resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_endpointcontrol_forticlientems", obj)
+
 	o, err := c.UpdateEndpointControlForticlientEms(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EndpointControlForticlientEms resource: %v", err)
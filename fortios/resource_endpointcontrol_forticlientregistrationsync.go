@@ -100,6 +100,8 @@ func resourceEndpointControlForticlientRegistrationSyncUpdate(d *schema.Resource
 		return fmt.Errorf("Error updating EndpointControlForticlientRegistrationSync resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_endpointcontrol_forticlientregistrationsync", obj)
+
 	o, err := c.UpdateEndpointControlForticlientRegistrationSync(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EndpointControlForticlientRegistrationSync resource: %v", err)
@@ -686,6 +686,8 @@ func resourceEndpointControlProfileUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating EndpointControlProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_endpointcontrol_profile", obj)
+
 	o, err := c.UpdateEndpointControlProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EndpointControlProfile resource: %v", err)
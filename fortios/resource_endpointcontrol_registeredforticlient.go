@@ -130,6 +130,8 @@ func resourceEndpointControlRegisteredForticlientUpdate(d *schema.ResourceData,
 		return fmt.Errorf("Error updating EndpointControlRegisteredForticlient resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_endpointcontrol_registeredforticlient", obj)
+
 	o, err := c.UpdateEndpointControlRegisteredForticlient(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EndpointControlRegisteredForticlient resource: %v", err)
@@ -141,6 +141,8 @@ func resourceEndpointControlSettingsUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating EndpointControlSettings resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_endpointcontrol_settings", obj)
+
 	o, err := c.UpdateEndpointControlSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EndpointControlSettings resource: %v", err)
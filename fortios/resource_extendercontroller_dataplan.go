@@ -197,6 +197,8 @@ func resourceExtenderControllerDataplanUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating ExtenderControllerDataplan resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_extendercontroller_dataplan", obj)
+
 	o, err := c.UpdateExtenderControllerDataplan(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ExtenderControllerDataplan resource: %v", err)
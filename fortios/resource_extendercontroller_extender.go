@@ -649,6 +649,8 @@ func resourceExtenderControllerExtenderUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating ExtenderControllerExtender resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_extendercontroller_extender", obj)
+
 	o, err := c.UpdateExtenderControllerExtender(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ExtenderControllerExtender resource: %v", err)
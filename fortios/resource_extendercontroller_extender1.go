@@ -397,6 +397,8 @@ func resourceExtenderControllerExtender1Update(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating ExtenderControllerExtender1 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_extendercontroller_extender1", obj)
+
 	o, err := c.UpdateExtenderControllerExtender1(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ExtenderControllerExtender1 resource: %v", err)
@@ -578,6 +578,8 @@ func resourceExtenderControllerExtenderProfileUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating ExtenderControllerExtenderProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_extendercontroller_extenderprofile", obj)
+
 	o, err := c.UpdateExtenderControllerExtenderProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ExtenderControllerExtenderProfile resource: %v", err)
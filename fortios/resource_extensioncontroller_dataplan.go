@@ -196,6 +196,8 @@ func resourceExtensionControllerDataplanUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating ExtensionControllerDataplan resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_extensioncontroller_dataplan", obj)
+
 	o, err := c.UpdateExtensionControllerDataplan(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ExtensionControllerDataplan resource: %v", err)
@@ -208,6 +208,8 @@ func resourceExtensionControllerExtenderUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating ExtensionControllerExtender resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_extensioncontroller_extender", obj)
+
 	o, err := c.UpdateExtensionControllerExtender(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ExtensionControllerExtender resource: %v", err)
@@ -578,6 +578,8 @@ func resourceExtensionControllerExtenderProfileUpdate(d *schema.ResourceData, m
 		return fmt.Errorf("Error updating ExtensionControllerExtenderProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_extensioncontroller_extenderprofile", obj)
+
 	o, err := c.UpdateExtensionControllerExtenderProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ExtensionControllerExtenderProfile resource: %v", err)
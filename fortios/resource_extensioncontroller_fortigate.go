@@ -135,6 +135,8 @@ func resourceExtensionControllerFortigateUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating ExtensionControllerFortigate resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_extensioncontroller_fortigate", obj)
+
 	o, err := c.UpdateExtensionControllerFortigate(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ExtensionControllerFortigate resource: %v", err)
@@ -135,6 +135,8 @@ func resourceExtensionControllerFortigateProfileUpdate(d *schema.ResourceData, m
 		return fmt.Errorf("Error updating ExtensionControllerFortigateProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_extensioncontroller_fortigateprofile", obj)
+
 	o, err := c.UpdateExtensionControllerFortigateProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ExtensionControllerFortigateProfile resource: %v", err)
@@ -185,6 +185,8 @@ func resourceFileFilterProfileUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating FileFilterProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_filefilter_profile", obj)
+
 	o, err := c.UpdateFileFilterProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FileFilterProfile resource: %v", err)
@@ -218,6 +218,8 @@ func resourceFirewallDosPolicyUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating FirewallDosPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_DoSpolicy", obj)
+
 	o, err := c.UpdateFirewallDosPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallDosPolicy resource: %v", err)
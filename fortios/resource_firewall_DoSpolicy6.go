@@ -218,6 +218,8 @@ func resourceFirewallDosPolicy6Update(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating FirewallDosPolicy6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_DoSpolicy6", obj)
+
 	o, err := c.UpdateFirewallDosPolicy6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallDosPolicy6 resource: %v", err)
@@ -678,6 +678,8 @@ func resourceFirewallAccessProxyUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating FirewallAccessProxy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_accessproxy", obj)
+
 	o, err := c.UpdateFirewallAccessProxy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallAccessProxy resource: %v", err)
@@ -678,6 +678,8 @@ func resourceFirewallAccessProxy6Update(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating FirewallAccessProxy6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_accessproxy6", obj)
+
 	o, err := c.UpdateFirewallAccessProxy6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallAccessProxy6 resource: %v", err)
@@ -166,6 +166,8 @@ func resourceFirewallAccessProxySshClientCertUpdate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error updating FirewallAccessProxySshClientCert resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_accessproxysshclientcert", obj)
+
 	o, err := c.UpdateFirewallAccessProxySshClientCert(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallAccessProxySshClientCert resource: %v", err)
@@ -118,6 +118,8 @@ func resourceFirewallAccessProxyVirtualHostUpdate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error updating FirewallAccessProxyVirtualHost resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_accessproxyvirtualhost", obj)
+
 	o, err := c.UpdateFirewallAccessProxyVirtualHost(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallAccessProxyVirtualHost resource: %v", err)
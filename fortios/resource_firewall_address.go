@@ -355,6 +355,8 @@ func resourceFirewallAddressUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallAddress resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_address", obj)
+
 	o, err := c.UpdateFirewallAddress(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallAddress resource: %v", err)
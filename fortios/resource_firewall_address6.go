@@ -306,6 +306,8 @@ func resourceFirewallAddress6Update(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallAddress6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_address6", obj)
+
 	o, err := c.UpdateFirewallAddress6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallAddress6 resource: %v", err)
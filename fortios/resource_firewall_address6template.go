@@ -163,6 +163,8 @@ func resourceFirewallAddress6TemplateUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating FirewallAddress6Template resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_address6template", obj)
+
 	o, err := c.UpdateFirewallAddress6Template(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallAddress6Template resource: %v", err)
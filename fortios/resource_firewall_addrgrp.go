@@ -207,6 +207,8 @@ func resourceFirewallAddrgrpUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallAddrgrp resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_addrgrp", obj)
+
 	o, err := c.UpdateFirewallAddrgrp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallAddrgrp resource: %v", err)
@@ -174,6 +174,8 @@ func resourceFirewallAddrgrp6Update(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallAddrgrp6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_addrgrp6", obj)
+
 	o, err := c.UpdateFirewallAddrgrp6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallAddrgrp6 resource: %v", err)
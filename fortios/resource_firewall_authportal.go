@@ -94,6 +94,8 @@ func resourceFirewallAuthPortalUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating FirewallAuthPortal resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_authportal", obj)
+
 	o, err := c.UpdateFirewallAuthPortal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallAuthPortal resource: %v", err)
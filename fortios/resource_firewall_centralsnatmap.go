@@ -260,6 +260,8 @@ func resourceFirewallCentralSnatMapUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallCentralSnatMap resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_centralsnatmap", obj)
+
 	o, err := c.UpdateFirewallCentralSnatMap(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallCentralSnatMap resource: %v", err)
@@ -101,6 +101,8 @@ func resourceFirewallCityUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating FirewallCity resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_city", obj)
+
 	o, err := c.UpdateFirewallCity(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallCity resource: %v", err)
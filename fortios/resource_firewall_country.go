@@ -120,6 +120,8 @@ func resourceFirewallCountryUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallCountry resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_country", obj)
+
 	o, err := c.UpdateFirewallCountry(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallCountry resource: %v", err)
@@ -129,6 +129,8 @@ func resourceFirewallDecryptedTrafficMirrorUpdate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error updating FirewallDecryptedTrafficMirror resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_decryptedtrafficmirror", obj)
+
 	o, err := c.UpdateFirewallDecryptedTrafficMirror(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallDecryptedTrafficMirror resource: %v", err)
@@ -109,6 +109,8 @@ func resourceFirewallDnstranslationUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallDnstranslation resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_dnstranslation", obj)
+
 	o, err := c.UpdateFirewallDnstranslation(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallDnstranslation resource: %v", err)
@@ -62,6 +62,8 @@ func resourceFirewallGlobalUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating FirewallGlobal resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_global", obj)
+
 	o, err := c.UpdateFirewallGlobal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallGlobal resource: %v", err)
@@ -143,6 +143,8 @@ func resourceFirewallIdentityBasedRouteUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating FirewallIdentityBasedRoute resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_identitybasedroute", obj)
+
 	o, err := c.UpdateFirewallIdentityBasedRoute(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallIdentityBasedRoute resource: %v", err)
@@ -271,6 +271,8 @@ func resourceFirewallInterfacePolicyUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating FirewallInterfacePolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_interfacepolicy", obj)
+
 	o, err := c.UpdateFirewallInterfacePolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInterfacePolicy resource: %v", err)
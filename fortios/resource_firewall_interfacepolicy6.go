@@ -271,6 +271,8 @@ func resourceFirewallInterfacePolicy6Update(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating FirewallInterfacePolicy6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_interfacepolicy6", obj)
+
 	o, err := c.UpdateFirewallInterfacePolicy6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInterfacePolicy6 resource: %v", err)
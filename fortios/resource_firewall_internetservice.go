@@ -162,6 +162,8 @@ func resourceFirewallInternetServiceUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating FirewallInternetService resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_internetservice", obj)
+
 	o, err := c.UpdateFirewallInternetService(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetService resource: %v", err)
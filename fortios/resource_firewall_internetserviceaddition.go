@@ -154,6 +154,8 @@ func resourceFirewallInternetServiceAdditionUpdate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating FirewallInternetServiceAddition resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_internetserviceaddition", obj)
+
 	o, err := c.UpdateFirewallInternetServiceAddition(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceAddition resource: %v", err)
@@ -74,6 +74,8 @@ func resourceFirewallInternetServiceAppendUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating FirewallInternetServiceAppend resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_internetserviceappend", obj)
+
 	o, err := c.UpdateFirewallInternetServiceAppend(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceAppend resource: %v", err)
@@ -100,6 +100,8 @@ func resourceFirewallInternetServiceBotnetUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating FirewallInternetServiceBotnet resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_internetservicebotnet", obj)
+
 	o, err := c.UpdateFirewallInternetServiceBotnet(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceBotnet resource: %v", err)
@@ -188,6 +188,8 @@ func resourceFirewallInternetServiceCustomUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating FirewallInternetServiceCustom resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_internetservicecustom", obj)
+
 	o, err := c.UpdateFirewallInternetServiceCustom(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceCustom resource: %v", err)
@@ -119,6 +119,8 @@ func resourceFirewallInternetServiceCustomGroupUpdate(d *schema.ResourceData, m
 		return fmt.Errorf("Error updating FirewallInternetServiceCustomGroup resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_internetservicecustomgroup", obj)
+
 	o, err := c.UpdateFirewallInternetServiceCustomGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceCustomGroup resource: %v", err)
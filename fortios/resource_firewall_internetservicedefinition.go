@@ -160,6 +160,8 @@ func resourceFirewallInternetServiceDefinitionUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating FirewallInternetServiceDefinition resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_internetservicedefinition", obj)
+
 	o, err := c.UpdateFirewallInternetServiceDefinition(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceDefinition resource: %v", err)
@@ -283,6 +283,8 @@ func resourceFirewallInternetServiceExtensionUpdate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error updating FirewallInternetServiceExtension resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_internetserviceextension", obj)
+
 	o, err := c.UpdateFirewallInternetServiceExtension(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceExtension resource: %v", err)
@@ -129,6 +129,8 @@ func resourceFirewallInternetServiceGroupUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating FirewallInternetServiceGroup resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_internetservicegroup", obj)
+
 	o, err := c.UpdateFirewallInternetServiceGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceGroup resource: %v", err)
@@ -100,6 +100,8 @@ func resourceFirewallInternetServiceIpblReasonUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating FirewallInternetServiceIpblReason resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_internetserviceipblreason", obj)
+
 	o, err := c.UpdateFirewallInternetServiceIpblReason(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceIpblReason resource: %v", err)
@@ -100,6 +100,8 @@ func resourceFirewallInternetServiceIpblVendorUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating FirewallInternetServiceIpblVendor resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_internetserviceipblvendor", obj)
+
 	o, err := c.UpdateFirewallInternetServiceIpblVendor(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceIpblVendor resource: %v", err)
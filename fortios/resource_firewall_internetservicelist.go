@@ -100,6 +100,8 @@ func resourceFirewallInternetServiceListUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating FirewallInternetServiceList resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_internetservicelist", obj)
+
 	o, err := c.UpdateFirewallInternetServiceList(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceList resource: %v", err)
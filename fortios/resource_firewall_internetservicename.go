@@ -120,6 +120,8 @@ func resourceFirewallInternetServiceNameUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating FirewallInternetServiceName resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_internetservicename", obj)
+
 	o, err := c.UpdateFirewallInternetServiceName(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceName resource: %v", err)
@@ -100,6 +100,8 @@ func resourceFirewallInternetServiceOwnerUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating FirewallInternetServiceOwner resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_internetserviceowner", obj)
+
 	o, err := c.UpdateFirewallInternetServiceOwner(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceOwner resource: %v", err)
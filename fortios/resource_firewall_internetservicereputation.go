@@ -100,6 +100,8 @@ func resourceFirewallInternetServiceReputationUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating FirewallInternetServiceReputation resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_internetservicereputation", obj)
+
 	o, err := c.UpdateFirewallInternetServiceReputation(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceReputation resource: %v", err)
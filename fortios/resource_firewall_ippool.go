@@ -190,6 +190,8 @@ func resourceFirewallIppoolUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating FirewallIppool resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_ippool", obj)
+
 	o, err := c.UpdateFirewallIppool(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallIppool resource: %v", err)
@@ -118,6 +118,8 @@ func resourceFirewallIppool6Update(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallIppool6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_ippool6", obj)
+
 	o, err := c.UpdateFirewallIppool6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallIppool6 resource: %v", err)
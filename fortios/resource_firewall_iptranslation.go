@@ -111,6 +111,8 @@ func resourceFirewallIpTranslationUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallIpTranslation resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_iptranslation", obj)
+
 	o, err := c.UpdateFirewallIpTranslation(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallIpTranslation resource: %v", err)
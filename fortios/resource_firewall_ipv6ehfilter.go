@@ -99,6 +99,8 @@ func resourceFirewallIpv6EhFilterUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating FirewallIpv6EhFilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_ipv6ehfilter", obj)
+
 	o, err := c.UpdateFirewallIpv6EhFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallIpv6EhFilter resource: %v", err)
@@ -162,6 +162,8 @@ func resourceFirewallLdbMonitorUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating FirewallLdbMonitor resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_ldbmonitor", obj)
+
 	o, err := c.UpdateFirewallLdbMonitor(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallLdbMonitor resource: %v", err)
@@ -193,6 +193,8 @@ func resourceFirewallLocalInPolicyUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallLocalInPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_localinpolicy", obj)
+
 	o, err := c.UpdateFirewallLocalInPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallLocalInPolicy resource: %v", err)
@@ -187,6 +187,8 @@ func resourceFirewallLocalInPolicy6Update(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallLocalInPolicy6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_localinpolicy6", obj)
+
 	o, err := c.UpdateFirewallLocalInPolicy6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallLocalInPolicy6 resource: %v", err)
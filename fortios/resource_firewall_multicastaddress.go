@@ -176,6 +176,8 @@ func resourceFirewallMulticastAddressUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating FirewallMulticastAddress resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_multicastaddress", obj)
+
 	o, err := c.UpdateFirewallMulticastAddress(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallMulticastAddress resource: %v", err)
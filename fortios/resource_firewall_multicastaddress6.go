@@ -154,6 +154,8 @@ func resourceFirewallMulticastAddress6Update(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating FirewallMulticastAddress6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_multicastaddress6", obj)
+
 	o, err := c.UpdateFirewallMulticastAddress6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallMulticastAddress6 resource: %v", err)
@@ -213,6 +213,8 @@ func resourceFirewallMulticastPolicyUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating FirewallMulticastPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_multicastpolicy", obj)
+
 	o, err := c.UpdateFirewallMulticastPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallMulticastPolicy resource: %v", err)
@@ -192,6 +192,8 @@ func resourceFirewallMulticastPolicy6Update(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating FirewallMulticastPolicy6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_multicastpolicy6", obj)
+
 	o, err := c.UpdateFirewallMulticastPolicy6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallMulticastPolicy6 resource: %v", err)
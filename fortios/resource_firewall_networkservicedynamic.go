@@ -111,6 +111,8 @@ func resourceFirewallNetworkServiceDynamicUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating FirewallNetworkServiceDynamic resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_networkservicedynamic", obj)
+
 	o, err := c.UpdateFirewallNetworkServiceDynamic(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallNetworkServiceDynamic resource: %v", err)
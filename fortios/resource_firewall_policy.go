@@ -1400,6 +1400,8 @@ func resourceFirewallPolicyUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating FirewallPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_policy", obj)
+
 	o, err := c.UpdateFirewallPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallPolicy resource: %v", err)
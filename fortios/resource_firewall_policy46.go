@@ -252,6 +252,8 @@ func resourceFirewallPolicy46Update(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallPolicy46 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_policy46", obj)
+
 	o, err := c.UpdateFirewallPolicy46(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallPolicy46 resource: %v", err)
@@ -718,6 +718,8 @@ func resourceFirewallPolicy6Update(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallPolicy6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_policy6", obj)
+
 	o, err := c.UpdateFirewallPolicy6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallPolicy6 resource: %v", err)
@@ -252,6 +252,8 @@ func resourceFirewallPolicy64Update(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallPolicy64 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_policy64", obj)
+
 	o, err := c.UpdateFirewallPolicy64(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallPolicy64 resource: %v", err)
@@ -208,6 +208,8 @@ func resourceFirewallProfileGroupUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating FirewallProfileGroup resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_profilegroup", obj)
+
 	o, err := c.UpdateFirewallProfileGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallProfileGroup resource: %v", err)
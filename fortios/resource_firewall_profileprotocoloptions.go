@@ -944,6 +944,8 @@ func resourceFirewallProfileProtocolOptionsUpdate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error updating FirewallProfileProtocolOptions resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_profileprotocoloptions", obj)
+
 	o, err := c.UpdateFirewallProfileProtocolOptions(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallProfileProtocolOptions resource: %v", err)
@@ -273,6 +273,8 @@ func resourceFirewallProxyAddressUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating FirewallProxyAddress resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_proxyaddress", obj)
+
 	o, err := c.UpdateFirewallProxyAddress(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallProxyAddress resource: %v", err)
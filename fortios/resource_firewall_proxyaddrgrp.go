@@ -174,6 +174,8 @@ func resourceFirewallProxyAddrgrpUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating FirewallProxyAddrgrp resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_proxyaddrgrp", obj)
+
 	o, err := c.UpdateFirewallProxyAddrgrp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallProxyAddrgrp resource: %v", err)
@@ -642,6 +642,8 @@ func resourceFirewallProxyPolicyUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating FirewallProxyPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_proxypolicy", obj)
+
 	o, err := c.UpdateFirewallProxyPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallProxyPolicy resource: %v", err)
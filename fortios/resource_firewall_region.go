@@ -120,6 +120,8 @@ func resourceFirewallRegionUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating FirewallRegion resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_region", obj)
+
 	o, err := c.UpdateFirewallRegion(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallRegion resource: %v", err)
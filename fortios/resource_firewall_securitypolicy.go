@@ -806,6 +806,8 @@ func resourceFirewallSecurityPolicyUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallSecurityPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_securitypolicy", obj)
+
 	o, err := c.UpdateFirewallSecurityPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallSecurityPolicy resource: %v", err)
@@ -517,6 +517,8 @@ func resourceFirewallShapingPolicyUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallShapingPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_shapingpolicy", obj)
+
 	o, err := c.UpdateFirewallShapingPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallShapingPolicy resource: %v", err)
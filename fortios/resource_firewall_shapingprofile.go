@@ -184,6 +184,8 @@ func resourceFirewallShapingProfileUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallShapingProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_shapingprofile", obj)
+
 	o, err := c.UpdateFirewallShapingProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallShapingProfile resource: %v", err)
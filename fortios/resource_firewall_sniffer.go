@@ -342,6 +342,8 @@ func resourceFirewallSnifferUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallSniffer resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_sniffer", obj)
+
 	o, err := c.UpdateFirewallSniffer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallSniffer resource: %v", err)
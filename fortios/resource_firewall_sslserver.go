@@ -160,6 +160,8 @@ func resourceFirewallSslServerUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating FirewallSslServer resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_sslserver", obj)
+
 	o, err := c.UpdateFirewallSslServer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallSslServer resource: %v", err)
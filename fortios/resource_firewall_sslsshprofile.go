@@ -1004,6 +1004,8 @@ func resourceFirewallSslSshProfileUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallSslSshProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_sslsshprofile", obj)
+
 	o, err := c.UpdateFirewallSslSshProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallSslSshProfile resource: %v", err)
@@ -101,6 +101,8 @@ func resourceFirewallTrafficClassUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating FirewallTrafficClass resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_trafficclass", obj)
+
 	o, err := c.UpdateFirewallTrafficClass(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallTrafficClass resource: %v", err)
@@ -151,6 +151,8 @@ func resourceFirewallTtlPolicyUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating FirewallTtlPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_ttlpolicy", obj)
+
 	o, err := c.UpdateFirewallTtlPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallTtlPolicy resource: %v", err)
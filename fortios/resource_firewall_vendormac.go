@@ -111,6 +111,8 @@ func resourceFirewallVendorMacUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating FirewallVendorMac resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_vendormac", obj)
+
 	o, err := c.UpdateFirewallVendorMac(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallVendorMac resource: %v", err)
@@ -695,6 +695,8 @@ func resourceFirewallVipUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating FirewallVip resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_vip", obj)
+
 	o, err := c.UpdateFirewallVip(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallVip resource: %v", err)
@@ -274,6 +274,8 @@ func resourceFirewallVip46Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating FirewallVip46 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_vip46", obj)
+
 	o, err := c.UpdateFirewallVip46(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallVip46 resource: %v", err)
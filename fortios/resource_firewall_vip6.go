@@ -602,6 +602,8 @@ func resourceFirewallVip6Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating FirewallVip6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_vip6", obj)
+
 	o, err := c.UpdateFirewallVip6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallVip6 resource: %v", err)
@@ -260,6 +260,8 @@ func resourceFirewallVip64Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating FirewallVip64 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_vip64", obj)
+
 	o, err := c.UpdateFirewallVip64(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallVip64 resource: %v", err)
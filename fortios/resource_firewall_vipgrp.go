@@ -135,6 +135,8 @@ func resourceFirewallVipgrpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating FirewallVipgrp resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_vipgrp", obj)
+
 	o, err := c.UpdateFirewallVipgrp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallVipgrp resource: %v", err)
@@ -130,6 +130,8 @@ func resourceFirewallVipgrp46Update(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallVipgrp46 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_vipgrp46", obj)
+
 	o, err := c.UpdateFirewallVipgrp46(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallVipgrp46 resource: %v", err)
@@ -130,6 +130,8 @@ func resourceFirewallVipgrp6Update(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallVipgrp6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_vipgrp6", obj)
+
 	o, err := c.UpdateFirewallVipgrp6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallVipgrp6 resource: %v", err)
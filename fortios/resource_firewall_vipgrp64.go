@@ -130,6 +130,8 @@ func resourceFirewallVipgrp64Update(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallVipgrp64 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewall_vipgrp64", obj)
+
 	o, err := c.UpdateFirewallVipgrp64(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallVipgrp64 resource: %v", err)
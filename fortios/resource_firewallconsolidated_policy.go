@@ -803,6 +803,8 @@ func resourceFirewallConsolidatedPolicyUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating FirewallConsolidatedPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewallconsolidated_policy", obj)
+
 	o, err := c.UpdateFirewallConsolidatedPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallConsolidatedPolicy resource: %v", err)
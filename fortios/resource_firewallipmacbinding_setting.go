@@ -72,6 +72,8 @@ func resourceFirewallIpmacbindingSettingUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating FirewallIpmacbindingSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewallipmacbinding_setting", obj)
+
 	o, err := c.UpdateFirewallIpmacbindingSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallIpmacbindingSetting resource: %v", err)
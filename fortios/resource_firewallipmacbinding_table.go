@@ -114,6 +114,8 @@ func resourceFirewallIpmacbindingTableUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating FirewallIpmacbindingTable resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewallipmacbinding_table", obj)
+
 	o, err := c.UpdateFirewallIpmacbindingTable(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallIpmacbindingTable resource: %v", err)
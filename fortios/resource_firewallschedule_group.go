@@ -124,6 +124,8 @@ func resourceFirewallScheduleGroupUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallScheduleGroup resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewallschedule_group", obj)
+
 	o, err := c.UpdateFirewallScheduleGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallScheduleGroup resource: %v", err)
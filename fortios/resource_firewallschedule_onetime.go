@@ -119,6 +119,8 @@ func resourceFirewallScheduleOnetimeUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating FirewallScheduleOnetime resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewallschedule_onetime", obj)
+
 	o, err := c.UpdateFirewallScheduleOnetime(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallScheduleOnetime resource: %v", err)
@@ -118,6 +118,8 @@ func resourceFirewallScheduleRecurringUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating FirewallScheduleRecurring resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewallschedule_recurring", obj)
+
 	o, err := c.UpdateFirewallScheduleRecurring(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallScheduleRecurring resource: %v", err)
@@ -105,6 +105,8 @@ func resourceFirewallServiceCategoryUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating FirewallServiceCategory resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewallservice_category", obj)
+
 	o, err := c.UpdateFirewallServiceCategory(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallServiceCategory resource: %v", err)
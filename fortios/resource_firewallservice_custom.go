@@ -257,6 +257,8 @@ func resourceFirewallServiceCustomUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallServiceCustom resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewallservice_custom", obj)
+
 	o, err := c.UpdateFirewallServiceCustom(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallServiceCustom resource: %v", err)
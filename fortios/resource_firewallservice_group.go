@@ -134,6 +134,8 @@ func resourceFirewallServiceGroupUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating FirewallServiceGroup resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewallservice_group", obj)
+
 	o, err := c.UpdateFirewallServiceGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallServiceGroup resource: %v", err)
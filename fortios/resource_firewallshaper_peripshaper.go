@@ -144,6 +144,8 @@ func resourceFirewallShaperPerIpShaperUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating FirewallShaperPerIpShaper resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewallshaper_peripshaper", obj)
+
 	o, err := c.UpdateFirewallShaperPerIpShaper(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallShaperPerIpShaper resource: %v", err)
@@ -164,6 +164,8 @@ func resourceFirewallShaperTrafficShaperUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating FirewallShaperTrafficShaper resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewallshaper_trafficshaper", obj)
+
 	o, err := c.UpdateFirewallShaperTrafficShaper(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallShaperTrafficShaper resource: %v", err)
@@ -137,6 +137,8 @@ func resourceFirewallSshHostKeyUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating FirewallSshHostKey resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewallssh_hostkey", obj)
+
 	o, err := c.UpdateFirewallSshHostKey(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallSshHostKey resource: %v", err)
@@ -116,6 +116,8 @@ func resourceFirewallSshLocalCaUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating FirewallSshLocalCa resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewallssh_localca", obj)
+
 	o, err := c.UpdateFirewallSshLocalCa(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallSshLocalCa resource: %v", err)
@@ -116,6 +116,8 @@ func resourceFirewallSshLocalKeyUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating FirewallSshLocalKey resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewallssh_localkey", obj)
+
 	o, err := c.UpdateFirewallSshLocalKey(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallSshLocalKey resource: %v", err)
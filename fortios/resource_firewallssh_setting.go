@@ -110,6 +110,8 @@ func resourceFirewallSshSettingUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating FirewallSshSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewallssh_setting", obj)
+
 	o, err := c.UpdateFirewallSshSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallSshSetting resource: %v", err)
@@ -111,6 +111,8 @@ func resourceFirewallSslSettingUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating FirewallSslSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewallssl_setting", obj)
+
 	o, err := c.UpdateFirewallSslSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallSslSetting resource: %v", err)
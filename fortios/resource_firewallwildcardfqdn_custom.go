@@ -122,6 +122,8 @@ func resourceFirewallWildcardFqdnCustomUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating FirewallWildcardFqdnCustom resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewallwildcardfqdn_custom", obj)
+
 	o, err := c.UpdateFirewallWildcardFqdnCustom(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallWildcardFqdnCustom resource: %v", err)
@@ -135,6 +135,8 @@ func resourceFirewallWildcardFqdnGroupUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating FirewallWildcardFqdnGroup resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_firewallwildcardfqdn_group", obj)
+
 	o, err := c.UpdateFirewallWildcardFqdnGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallWildcardFqdnGroup resource: %v", err)
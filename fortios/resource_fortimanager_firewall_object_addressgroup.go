@@ -0,0 +1,102 @@
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var fmgFirewallObjectAddressGroup = fmgObject{
+	path: "firewall/addrgrp",
+	attrs: map[string]string{
+		"name":    "name",
+		"member":  "member",
+		"comment": "comment",
+	},
+}
+
+func resourceFortimanagerFirewallObjectAddressGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: createFMGFirewallObjectAddressGroup,
+		Read:   readFMGFirewallObjectAddressGroup,
+		Update: updateFMGFirewallObjectAddressGroup,
+		Delete: deleteFMGFirewallObjectAddressGroup,
+
+		Importer: &schema.ResourceImporter{
+			State: fmgObjectImportState(fmgFirewallObjectAddressGroup),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"member": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"adom": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "root",
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func createFMGFirewallObjectAddressGroup(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("createFMGFirewallObjectAddressGroup")()
+
+	err := createFMGObject(d, m, fmgFirewallObjectAddressGroup)
+	if err != nil {
+		return fmt.Errorf("Error creating Firewall Object Address Group: %s", err)
+	}
+
+	return readFMGFirewallObjectAddressGroup(d, m)
+}
+
+func readFMGFirewallObjectAddressGroup(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("readFMGFirewallObjectAddressGroup")()
+
+	err := readFMGObject(d, m, fmgFirewallObjectAddressGroup)
+	if err != nil {
+		return fmt.Errorf("Error reading Firewall Object Address Group: %s", err)
+	}
+
+	return nil
+}
+
+func updateFMGFirewallObjectAddressGroup(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("updateFMGFirewallObjectAddressGroup")()
+
+	err := updateFMGObject(d, m, fmgFirewallObjectAddressGroup)
+	if err != nil {
+		return fmt.Errorf("Error updating Firewall Object Address Group: %s", err)
+	}
+
+	return readFMGFirewallObjectAddressGroup(d, m)
+}
+
+func deleteFMGFirewallObjectAddressGroup(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("deleteFMGFirewallObjectAddressGroup")()
+
+	err := deleteFMGObject(d, m, fmgFirewallObjectAddressGroup)
+	if err != nil {
+		return fmt.Errorf("Error deleting Firewall Object Address Group: %s", err)
+	}
+
+	return nil
+}
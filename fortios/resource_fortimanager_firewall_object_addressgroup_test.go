@@ -0,0 +1,96 @@
+package fortios
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFortiManagerFirewallObjectAddressGroup(t *testing.T) {
+	name := "fmg-firewall-object-addressgroup" + acctest.RandString(12)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckFortiManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFMGFirewallObjectAddressGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFortiManagerFirewallObjectAddressGroupConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFortiManagerFirewallObjectAddressGroupExists("fortios_fmg_firewall_object_addressgroup.test1"),
+					resource.TestCheckResourceAttr("fortios_fmg_firewall_object_addressgroup.test1", "name", name),
+					resource.TestCheckResourceAttr("fortios_fmg_firewall_object_addressgroup.test1", "member.0", "all"),
+					resource.TestCheckResourceAttr("fortios_fmg_firewall_object_addressgroup.test1", "comment", "test obj address group"),
+				),
+			},
+			{
+				ResourceName:      "fortios_fmg_firewall_object_addressgroup.test1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFortiManagerFirewallObjectAddressGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found Firewall Object Address Group: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Firewall Object Address Group is set")
+		}
+
+		c := testAccProvider.Meta().(*FortiClient).ClientFortimanager
+
+		i := rs.Primary.ID
+		o, err := fmgReadObject(c, "root", fmgFirewallObjectAddressGroup.path, i)
+
+		if err != nil {
+			return fmt.Errorf("Error reading Firewall Object Address Group: %s", err)
+		}
+
+		if o == nil {
+			return fmt.Errorf("Error creating Firewall Object Address Group: %s", n)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFMGFirewallObjectAddressGroupDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*FortiClient).ClientFortimanager
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fortios_fmg_firewall_object_addressgroup" {
+			continue
+		}
+
+		i := rs.Primary.ID
+		o, err := fmgReadObject(c, "root", fmgFirewallObjectAddressGroup.path, i)
+
+		if err == nil {
+			if o != nil {
+				return fmt.Errorf("Error Firewall Object Address Group %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func testAccFortiManagerFirewallObjectAddressGroupConfig(name string) string {
+	return fmt.Sprintf(`
+resource "fortios_fmg_firewall_object_addressgroup" "test1" {
+    name = "%s"
+    member = ["all"]
+    comment = "test obj address group"
+}
+`, name)
+}
@@ -0,0 +1,125 @@
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var fmgFirewallObjectProfileGroup = fmgObject{
+	path: "firewall/profile-group",
+	attrs: map[string]string{
+		"name":              "name",
+		"av_profile":        "av-profile",
+		"webfilter_profile": "webfilter-profile",
+		"dnsfilter_profile": "dnsfilter-profile",
+		"ips_sensor":        "ips-sensor",
+		"application_list":  "application-list",
+		"ssl_ssh_profile":   "ssl-ssh-profile",
+	},
+}
+
+func resourceFortimanagerFirewallObjectProfileGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: createFMGFirewallObjectProfileGroup,
+		Read:   readFMGFirewallObjectProfileGroup,
+		Update: updateFMGFirewallObjectProfileGroup,
+		Delete: deleteFMGFirewallObjectProfileGroup,
+
+		Importer: &schema.ResourceImporter{
+			State: fmgObjectImportState(fmgFirewallObjectProfileGroup),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"av_profile": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"webfilter_profile": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"dnsfilter_profile": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"ips_sensor": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"application_list": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"ssl_ssh_profile": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"adom": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "root",
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func createFMGFirewallObjectProfileGroup(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("createFMGFirewallObjectProfileGroup")()
+
+	err := createFMGObject(d, m, fmgFirewallObjectProfileGroup)
+	if err != nil {
+		return fmt.Errorf("Error creating Firewall Object Profile Group: %s", err)
+	}
+
+	return readFMGFirewallObjectProfileGroup(d, m)
+}
+
+func readFMGFirewallObjectProfileGroup(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("readFMGFirewallObjectProfileGroup")()
+
+	err := readFMGObject(d, m, fmgFirewallObjectProfileGroup)
+	if err != nil {
+		return fmt.Errorf("Error reading Firewall Object Profile Group: %s", err)
+	}
+
+	return nil
+}
+
+func updateFMGFirewallObjectProfileGroup(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("updateFMGFirewallObjectProfileGroup")()
+
+	err := updateFMGObject(d, m, fmgFirewallObjectProfileGroup)
+	if err != nil {
+		return fmt.Errorf("Error updating Firewall Object Profile Group: %s", err)
+	}
+
+	return readFMGFirewallObjectProfileGroup(d, m)
+}
+
+func deleteFMGFirewallObjectProfileGroup(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("deleteFMGFirewallObjectProfileGroup")()
+
+	err := deleteFMGObject(d, m, fmgFirewallObjectProfileGroup)
+	if err != nil {
+		return fmt.Errorf("Error deleting Firewall Object Profile Group: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package fortios
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFortiManagerFirewallObjectProfileGroup(t *testing.T) {
+	name := "fmg-firewall-object-profilegroup" + acctest.RandString(12)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckFortiManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFMGFirewallObjectProfileGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFortiManagerFirewallObjectProfileGroupConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFortiManagerFirewallObjectProfileGroupExists("fortios_fmg_firewall_object_profilegroup.test1"),
+					resource.TestCheckResourceAttr("fortios_fmg_firewall_object_profilegroup.test1", "name", name),
+					resource.TestCheckResourceAttr("fortios_fmg_firewall_object_profilegroup.test1", "av_profile", "default"),
+					resource.TestCheckResourceAttr("fortios_fmg_firewall_object_profilegroup.test1", "ips_sensor", "default"),
+					resource.TestCheckResourceAttr("fortios_fmg_firewall_object_profilegroup.test1", "ssl_ssh_profile", "certificate-inspection"),
+				),
+			},
+			{
+				ResourceName:      "fortios_fmg_firewall_object_profilegroup.test1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFortiManagerFirewallObjectProfileGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found Firewall Object Profile Group: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Firewall Object Profile Group is set")
+		}
+
+		c := testAccProvider.Meta().(*FortiClient).ClientFortimanager
+
+		i := rs.Primary.ID
+		o, err := fmgReadObject(c, "root", fmgFirewallObjectProfileGroup.path, i)
+
+		if err != nil {
+			return fmt.Errorf("Error reading Firewall Object Profile Group: %s", err)
+		}
+
+		if o == nil {
+			return fmt.Errorf("Error creating Firewall Object Profile Group: %s", n)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFMGFirewallObjectProfileGroupDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*FortiClient).ClientFortimanager
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fortios_fmg_firewall_object_profilegroup" {
+			continue
+		}
+
+		i := rs.Primary.ID
+		o, err := fmgReadObject(c, "root", fmgFirewallObjectProfileGroup.path, i)
+
+		if err == nil {
+			if o != nil {
+				return fmt.Errorf("Error Firewall Object Profile Group %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func testAccFortiManagerFirewallObjectProfileGroupConfig(name string) string {
+	return fmt.Sprintf(`
+resource "fortios_fmg_firewall_object_profilegroup" "test1" {
+    name = "%s"
+    av_profile = "default"
+    ips_sensor = "default"
+    ssl_ssh_profile = "certificate-inspection"
+}
+`, name)
+}
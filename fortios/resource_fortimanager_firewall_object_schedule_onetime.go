@@ -0,0 +1,111 @@
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var fmgFirewallObjectScheduleOnetime = fmgObject{
+	path: "firewall/schedule/onetime",
+	attrs: map[string]string{
+		"name":            "name",
+		"start":           "start",
+		"end":             "end",
+		"expiration_days": "expiration-days",
+		"color":           "color",
+	},
+}
+
+func resourceFortimanagerFirewallObjectScheduleOnetime() *schema.Resource {
+	return &schema.Resource{
+		Create: createFMGFirewallObjectScheduleOnetime,
+		Read:   readFMGFirewallObjectScheduleOnetime,
+		Update: updateFMGFirewallObjectScheduleOnetime,
+		Delete: deleteFMGFirewallObjectScheduleOnetime,
+
+		Importer: &schema.ResourceImporter{
+			State: fmgObjectImportState(fmgFirewallObjectScheduleOnetime),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"start": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"end": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"expiration_days": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"color": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"adom": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "root",
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func createFMGFirewallObjectScheduleOnetime(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("createFMGFirewallObjectScheduleOnetime")()
+
+	err := createFMGObject(d, m, fmgFirewallObjectScheduleOnetime)
+	if err != nil {
+		return fmt.Errorf("Error creating Firewall Object Schedule Onetime: %s", err)
+	}
+
+	return readFMGFirewallObjectScheduleOnetime(d, m)
+}
+
+func readFMGFirewallObjectScheduleOnetime(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("readFMGFirewallObjectScheduleOnetime")()
+
+	err := readFMGObject(d, m, fmgFirewallObjectScheduleOnetime)
+	if err != nil {
+		return fmt.Errorf("Error reading Firewall Object Schedule Onetime: %s", err)
+	}
+
+	return nil
+}
+
+func updateFMGFirewallObjectScheduleOnetime(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("updateFMGFirewallObjectScheduleOnetime")()
+
+	err := updateFMGObject(d, m, fmgFirewallObjectScheduleOnetime)
+	if err != nil {
+		return fmt.Errorf("Error updating Firewall Object Schedule Onetime: %s", err)
+	}
+
+	return readFMGFirewallObjectScheduleOnetime(d, m)
+}
+
+func deleteFMGFirewallObjectScheduleOnetime(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("deleteFMGFirewallObjectScheduleOnetime")()
+
+	err := deleteFMGObject(d, m, fmgFirewallObjectScheduleOnetime)
+	if err != nil {
+		return fmt.Errorf("Error deleting Firewall Object Schedule Onetime: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package fortios
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFortiManagerFirewallObjectScheduleOnetime(t *testing.T) {
+	name := "fmg-firewall-object-schedule-onetime" + acctest.RandString(12)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckFortiManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFMGFirewallObjectScheduleOnetimeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFortiManagerFirewallObjectScheduleOnetimeConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFortiManagerFirewallObjectScheduleOnetimeExists("fortios_fmg_firewall_object_schedule_onetime.test1"),
+					resource.TestCheckResourceAttr("fortios_fmg_firewall_object_schedule_onetime.test1", "name", name),
+					resource.TestCheckResourceAttr("fortios_fmg_firewall_object_schedule_onetime.test1", "start", "00:00 2023/01/01"),
+					resource.TestCheckResourceAttr("fortios_fmg_firewall_object_schedule_onetime.test1", "end", "23:59 2023/01/31"),
+				),
+			},
+			{
+				ResourceName:      "fortios_fmg_firewall_object_schedule_onetime.test1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFortiManagerFirewallObjectScheduleOnetimeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found Firewall Object Schedule Onetime: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Firewall Object Schedule Onetime is set")
+		}
+
+		c := testAccProvider.Meta().(*FortiClient).ClientFortimanager
+
+		i := rs.Primary.ID
+		o, err := fmgReadObject(c, "root", fmgFirewallObjectScheduleOnetime.path, i)
+
+		if err != nil {
+			return fmt.Errorf("Error reading Firewall Object Schedule Onetime: %s", err)
+		}
+
+		if o == nil {
+			return fmt.Errorf("Error creating Firewall Object Schedule Onetime: %s", n)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFMGFirewallObjectScheduleOnetimeDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*FortiClient).ClientFortimanager
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fortios_fmg_firewall_object_schedule_onetime" {
+			continue
+		}
+
+		i := rs.Primary.ID
+		o, err := fmgReadObject(c, "root", fmgFirewallObjectScheduleOnetime.path, i)
+
+		if err == nil {
+			if o != nil {
+				return fmt.Errorf("Error Firewall Object Schedule Onetime %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func testAccFortiManagerFirewallObjectScheduleOnetimeConfig(name string) string {
+	return fmt.Sprintf(`
+resource "fortios_fmg_firewall_object_schedule_onetime" "test1" {
+    name = "%s"
+    start = "00:00 2023/01/01"
+    end = "23:59 2023/01/31"
+}
+`, name)
+}
@@ -0,0 +1,116 @@
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var fmgFirewallObjectScheduleRecurring = fmgObject{
+	path: "firewall/schedule/recurring",
+	attrs: map[string]string{
+		"name":  "name",
+		"day":   "day",
+		"start": "start",
+		"end":   "end",
+		"color": "color",
+	},
+}
+
+func resourceFortimanagerFirewallObjectScheduleRecurring() *schema.Resource {
+	return &schema.Resource{
+		Create: createFMGFirewallObjectScheduleRecurring,
+		Read:   readFMGFirewallObjectScheduleRecurring,
+		Update: updateFMGFirewallObjectScheduleRecurring,
+		Delete: deleteFMGFirewallObjectScheduleRecurring,
+
+		Importer: &schema.ResourceImporter{
+			State: fmgObjectImportState(fmgFirewallObjectScheduleRecurring),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"day": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"start": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"end": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"color": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"adom": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "root",
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func createFMGFirewallObjectScheduleRecurring(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("createFMGFirewallObjectScheduleRecurring")()
+
+	err := createFMGObject(d, m, fmgFirewallObjectScheduleRecurring)
+	if err != nil {
+		return fmt.Errorf("Error creating Firewall Object Schedule Recurring: %s", err)
+	}
+
+	return readFMGFirewallObjectScheduleRecurring(d, m)
+}
+
+func readFMGFirewallObjectScheduleRecurring(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("readFMGFirewallObjectScheduleRecurring")()
+
+	err := readFMGObject(d, m, fmgFirewallObjectScheduleRecurring)
+	if err != nil {
+		return fmt.Errorf("Error reading Firewall Object Schedule Recurring: %s", err)
+	}
+
+	return nil
+}
+
+func updateFMGFirewallObjectScheduleRecurring(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("updateFMGFirewallObjectScheduleRecurring")()
+
+	err := updateFMGObject(d, m, fmgFirewallObjectScheduleRecurring)
+	if err != nil {
+		return fmt.Errorf("Error updating Firewall Object Schedule Recurring: %s", err)
+	}
+
+	return readFMGFirewallObjectScheduleRecurring(d, m)
+}
+
+func deleteFMGFirewallObjectScheduleRecurring(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("deleteFMGFirewallObjectScheduleRecurring")()
+
+	err := deleteFMGObject(d, m, fmgFirewallObjectScheduleRecurring)
+	if err != nil {
+		return fmt.Errorf("Error deleting Firewall Object Schedule Recurring: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package fortios
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFortiManagerFirewallObjectScheduleRecurring(t *testing.T) {
+	name := "fmg-firewall-object-schedule-recurring" + acctest.RandString(12)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckFortiManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFMGFirewallObjectScheduleRecurringDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFortiManagerFirewallObjectScheduleRecurringConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFortiManagerFirewallObjectScheduleRecurringExists("fortios_fmg_firewall_object_schedule_recurring.test1"),
+					resource.TestCheckResourceAttr("fortios_fmg_firewall_object_schedule_recurring.test1", "name", name),
+					resource.TestCheckResourceAttr("fortios_fmg_firewall_object_schedule_recurring.test1", "day.#", "2"),
+					resource.TestCheckResourceAttr("fortios_fmg_firewall_object_schedule_recurring.test1", "start", "08:00"),
+					resource.TestCheckResourceAttr("fortios_fmg_firewall_object_schedule_recurring.test1", "end", "18:00"),
+				),
+			},
+			{
+				ResourceName:      "fortios_fmg_firewall_object_schedule_recurring.test1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFortiManagerFirewallObjectScheduleRecurringExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found Firewall Object Schedule Recurring: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Firewall Object Schedule Recurring is set")
+		}
+
+		c := testAccProvider.Meta().(*FortiClient).ClientFortimanager
+
+		i := rs.Primary.ID
+		o, err := fmgReadObject(c, "root", fmgFirewallObjectScheduleRecurring.path, i)
+
+		if err != nil {
+			return fmt.Errorf("Error reading Firewall Object Schedule Recurring: %s", err)
+		}
+
+		if o == nil {
+			return fmt.Errorf("Error creating Firewall Object Schedule Recurring: %s", n)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFMGFirewallObjectScheduleRecurringDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*FortiClient).ClientFortimanager
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fortios_fmg_firewall_object_schedule_recurring" {
+			continue
+		}
+
+		i := rs.Primary.ID
+		o, err := fmgReadObject(c, "root", fmgFirewallObjectScheduleRecurring.path, i)
+
+		if err == nil {
+			if o != nil {
+				return fmt.Errorf("Error Firewall Object Schedule Recurring %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func testAccFortiManagerFirewallObjectScheduleRecurringConfig(name string) string {
+	return fmt.Sprintf(`
+resource "fortios_fmg_firewall_object_schedule_recurring" "test1" {
+    name = "%s"
+    day = ["monday", "friday"]
+    start = "08:00"
+    end = "18:00"
+}
+`, name)
+}
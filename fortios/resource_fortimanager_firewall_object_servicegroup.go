@@ -0,0 +1,102 @@
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var fmgFirewallObjectServiceGroup = fmgObject{
+	path: "firewall/service/group",
+	attrs: map[string]string{
+		"name":    "name",
+		"member":  "member",
+		"comment": "comment",
+	},
+}
+
+func resourceFortimanagerFirewallObjectServiceGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: createFMGFirewallObjectServiceGroup,
+		Read:   readFMGFirewallObjectServiceGroup,
+		Update: updateFMGFirewallObjectServiceGroup,
+		Delete: deleteFMGFirewallObjectServiceGroup,
+
+		Importer: &schema.ResourceImporter{
+			State: fmgObjectImportState(fmgFirewallObjectServiceGroup),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"member": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"adom": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "root",
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func createFMGFirewallObjectServiceGroup(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("createFMGFirewallObjectServiceGroup")()
+
+	err := createFMGObject(d, m, fmgFirewallObjectServiceGroup)
+	if err != nil {
+		return fmt.Errorf("Error creating Firewall Object Service Group: %s", err)
+	}
+
+	return readFMGFirewallObjectServiceGroup(d, m)
+}
+
+func readFMGFirewallObjectServiceGroup(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("readFMGFirewallObjectServiceGroup")()
+
+	err := readFMGObject(d, m, fmgFirewallObjectServiceGroup)
+	if err != nil {
+		return fmt.Errorf("Error reading Firewall Object Service Group: %s", err)
+	}
+
+	return nil
+}
+
+func updateFMGFirewallObjectServiceGroup(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("updateFMGFirewallObjectServiceGroup")()
+
+	err := updateFMGObject(d, m, fmgFirewallObjectServiceGroup)
+	if err != nil {
+		return fmt.Errorf("Error updating Firewall Object Service Group: %s", err)
+	}
+
+	return readFMGFirewallObjectServiceGroup(d, m)
+}
+
+func deleteFMGFirewallObjectServiceGroup(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("deleteFMGFirewallObjectServiceGroup")()
+
+	err := deleteFMGObject(d, m, fmgFirewallObjectServiceGroup)
+	if err != nil {
+		return fmt.Errorf("Error deleting Firewall Object Service Group: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package fortios
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFortiManagerFirewallObjectServiceGroup(t *testing.T) {
+	name := "fmg-firewall-object-servicegroup" + acctest.RandString(12)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckFortiManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFMGFirewallObjectServiceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFortiManagerFirewallObjectServiceGroupConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFortiManagerFirewallObjectServiceGroupExists("fortios_fmg_firewall_object_servicegroup.test1"),
+					resource.TestCheckResourceAttr("fortios_fmg_firewall_object_servicegroup.test1", "name", name),
+					resource.TestCheckResourceAttr("fortios_fmg_firewall_object_servicegroup.test1", "member.#", "2"),
+					resource.TestCheckResourceAttr("fortios_fmg_firewall_object_servicegroup.test1", "comment", "test obj service group"),
+				),
+			},
+			{
+				ResourceName:      "fortios_fmg_firewall_object_servicegroup.test1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFortiManagerFirewallObjectServiceGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found Firewall Object Service Group: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Firewall Object Service Group is set")
+		}
+
+		c := testAccProvider.Meta().(*FortiClient).ClientFortimanager
+
+		i := rs.Primary.ID
+		o, err := fmgReadObject(c, "root", fmgFirewallObjectServiceGroup.path, i)
+
+		if err != nil {
+			return fmt.Errorf("Error reading Firewall Object Service Group: %s", err)
+		}
+
+		if o == nil {
+			return fmt.Errorf("Error creating Firewall Object Service Group: %s", n)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFMGFirewallObjectServiceGroupDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*FortiClient).ClientFortimanager
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fortios_fmg_firewall_object_servicegroup" {
+			continue
+		}
+
+		i := rs.Primary.ID
+		o, err := fmgReadObject(c, "root", fmgFirewallObjectServiceGroup.path, i)
+
+		if err == nil {
+			if o != nil {
+				return fmt.Errorf("Error Firewall Object Service Group %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func testAccFortiManagerFirewallObjectServiceGroupConfig(name string) string {
+	return fmt.Sprintf(`
+resource "fortios_fmg_firewall_object_servicegroup" "test1" {
+    name = "%s"
+    member = ["HTTP", "HTTPS"]
+    comment = "test obj service group"
+}
+`, name)
+}
@@ -0,0 +1,108 @@
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var fmgFirewallObjectVipGroup = fmgObject{
+	path: "firewall/vipgrp",
+	attrs: map[string]string{
+		"name":      "name",
+		"interface": "interface",
+		"member":    "member",
+		"comments":  "comments",
+	},
+}
+
+func resourceFortimanagerFirewallObjectVipGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: createFMGFirewallObjectVipGroup,
+		Read:   readFMGFirewallObjectVipGroup,
+		Update: updateFMGFirewallObjectVipGroup,
+		Delete: deleteFMGFirewallObjectVipGroup,
+
+		Importer: &schema.ResourceImporter{
+			State: fmgObjectImportState(fmgFirewallObjectVipGroup),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"interface": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "any",
+			},
+			"member": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"comments": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"adom": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "root",
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func createFMGFirewallObjectVipGroup(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("createFMGFirewallObjectVipGroup")()
+
+	err := createFMGObject(d, m, fmgFirewallObjectVipGroup)
+	if err != nil {
+		return fmt.Errorf("Error creating Firewall Object Vip Group: %s", err)
+	}
+
+	return readFMGFirewallObjectVipGroup(d, m)
+}
+
+func readFMGFirewallObjectVipGroup(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("readFMGFirewallObjectVipGroup")()
+
+	err := readFMGObject(d, m, fmgFirewallObjectVipGroup)
+	if err != nil {
+		return fmt.Errorf("Error reading Firewall Object Vip Group: %s", err)
+	}
+
+	return nil
+}
+
+func updateFMGFirewallObjectVipGroup(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("updateFMGFirewallObjectVipGroup")()
+
+	err := updateFMGObject(d, m, fmgFirewallObjectVipGroup)
+	if err != nil {
+		return fmt.Errorf("Error updating Firewall Object Vip Group: %s", err)
+	}
+
+	return readFMGFirewallObjectVipGroup(d, m)
+}
+
+func deleteFMGFirewallObjectVipGroup(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("deleteFMGFirewallObjectVipGroup")()
+
+	err := deleteFMGObject(d, m, fmgFirewallObjectVipGroup)
+	if err != nil {
+		return fmt.Errorf("Error deleting Firewall Object Vip Group: %s", err)
+	}
+
+	return nil
+}
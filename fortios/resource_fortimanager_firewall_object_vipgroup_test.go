@@ -0,0 +1,103 @@
+package fortios
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFortiManagerFirewallObjectVipGroup(t *testing.T) {
+	name := "fmg-firewall-object-vipgroup" + acctest.RandString(12)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckFortiManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFMGFirewallObjectVipGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFortiManagerFirewallObjectVipGroupConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFortiManagerFirewallObjectVipGroupExists("fortios_fmg_firewall_object_vipgroup.test1"),
+					resource.TestCheckResourceAttr("fortios_fmg_firewall_object_vipgroup.test1", "name", name),
+					resource.TestCheckResourceAttr("fortios_fmg_firewall_object_vipgroup.test1", "interface", "any"),
+					resource.TestCheckResourceAttr("fortios_fmg_firewall_object_vipgroup.test1", "comments", "test obj vip group"),
+				),
+			},
+			{
+				ResourceName:      "fortios_fmg_firewall_object_vipgroup.test1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFortiManagerFirewallObjectVipGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found Firewall Object Vip Group: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Firewall Object Vip Group is set")
+		}
+
+		c := testAccProvider.Meta().(*FortiClient).ClientFortimanager
+
+		i := rs.Primary.ID
+		o, err := fmgReadObject(c, "root", fmgFirewallObjectVipGroup.path, i)
+
+		if err != nil {
+			return fmt.Errorf("Error reading Firewall Object Vip Group: %s", err)
+		}
+
+		if o == nil {
+			return fmt.Errorf("Error creating Firewall Object Vip Group: %s", n)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFMGFirewallObjectVipGroupDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*FortiClient).ClientFortimanager
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fortios_fmg_firewall_object_vipgroup" {
+			continue
+		}
+
+		i := rs.Primary.ID
+		o, err := fmgReadObject(c, "root", fmgFirewallObjectVipGroup.path, i)
+
+		if err == nil {
+			if o != nil {
+				return fmt.Errorf("Error Firewall Object Vip Group %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func testAccFortiManagerFirewallObjectVipGroupConfig(name string) string {
+	return fmt.Sprintf(`
+resource "fortios_fmg_firewall_object_vip" "test0" {
+    name = "%s-vip"
+    type = "static-nat"
+    ext_ip = "2.2.2.2"
+    mapped_ip = "1.1.1.1"
+}
+resource "fortios_fmg_firewall_object_vipgroup" "test1" {
+    name = "%s"
+    interface = "any"
+    member = [fortios_fmg_firewall_object_vip.test0.name]
+    comments = "test obj vip group"
+}
+`, name, name)
+}
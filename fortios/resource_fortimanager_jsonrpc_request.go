@@ -3,12 +3,21 @@ package fortios
 import (
 	"encoding/json"
 	"fmt"
+	"log"
+	"reflect"
 
+	fmgclient "github.com/fortinetdev/forti-sdk-go/fortimanager/sdkcore"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// The request is given either as json_content, optionally with the requests
+// reading and deleting its object in read_json and delete_json, or as the url
+// and the data of the object, which is then set, read and deleted by the
+// provider.
+
 func resourceFortimanagerJSONRPCRequest() *schema.Resource {
 	return &schema.Resource{
 		Create: handleFMGJSONRPCRequest,
@@ -19,9 +28,34 @@ func resourceFortimanagerJSONRPCRequest() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"json_content": &schema.Schema{
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				ExactlyOneOf: []string{"json_content", "url"},
+			},
+			"read_json": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsJSON,
+				ConflictsWith: []string{"url"},
+			},
+			"delete_json": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
 				ValidateFunc: validation.StringIsJSON,
 			},
+			"url": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"data": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				RequiredWith:     []string{"url"},
+				ConflictsWith:    []string{"json_content"},
+			},
 			"response": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -34,35 +68,192 @@ func resourceFortimanagerJSONRPCRequest() *schema.Resource {
 	}
 }
 
+// fmgJSONRPCRequest runs the JSON-RPC request s, which has a method and
+// params
+func fmgJSONRPCRequest(c *fmgclient.FmgSDKClient, s string) ([]map[string]interface{}, error) {
+	input := struct {
+		Method string                   `json:"method"`
+		Params []map[string]interface{} `json:"params"`
+	}{}
+	json.Unmarshal([]byte(s), &input)
+
+	if input.Method == "" || len(input.Params) == 0 {
+		return nil, fmt.Errorf("the request should have a method and params")
+	}
+
+	// all the params are sent in a single call
+	return fmgCall(c, input.Method, input.Params...)
+}
+
+func fmgJSONRPCResponse(result []map[string]interface{}) string {
+	if t, err := json.MarshalIndent(result, "", "    "); err == nil {
+		return string(t)
+	}
+
+	return "~"
+}
+
 func handleFMGJSONRPCRequest(d *schema.ResourceData, m interface{}) error {
 	c := m.(*FortiClient).ClientFortimanager
 	defer c.Trace("handleFMGJSONRPCRequest")()
 
-	jsonContent := d.Get("json_content").(string)
+	if url := d.Get("url").(string); url != "" {
+		var data map[string]interface{}
+		if err := json.Unmarshal([]byte(d.Get("data").(string)), &data); err != nil {
+			return fmt.Errorf("Error handling JSON RPC Request : data should be a JSON object: %s", err)
+		}
 
-	input := map[string]interface{}{}
-	json.Unmarshal([]byte(jsonContent), &input)
+		// the object is created or replaced, and then only updated
+		method := "set"
+		if !d.IsNewResource() {
+			method = "update"
+		}
+
+		result, err := fmgCall(c, method, map[string]interface{}{
+			"url":  url,
+			"data": data,
+		})
+		if err != nil {
+			return fmt.Errorf("Error handling JSON RPC Request : %s\n%s", err, fmgJSONRPCResponse(result))
+		}
 
-	data, err := c.HandleJSONRPCRequest(input)
+		d.SetId(url)
+		d.Set("response", fmgJSONRPCResponse(result))
+
+		return readFMGJSONRPCRequest(d, m)
+	}
+
+	result, err := fmgJSONRPCRequest(c, d.Get("json_content").(string))
 	if err != nil {
-		res_data := ""
-		if data != nil {
-			res_data = string(data)
+		res_data := "~"
+		if result != nil {
+			res_data = fmgJSONRPCResponse(result)
 		}
 		return fmt.Errorf("Error handling JSON RPC Request : %s\n%s", err, res_data)
 	}
 
-	d.SetId("JSONRPC-Requst-" + uuid.New().String())
-	d.Set("response", string(data))
+	if d.Id() == "" {
+		d.SetId("JSONRPC-Requst-" + uuid.New().String())
+	}
+	d.Set("response", fmgJSONRPCResponse(result))
 
 	return nil
 }
 
 func readFMGJSONRPCRequest(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("readFMGJSONRPCRequest")()
+
+	if url := d.Get("url").(string); url != "" {
+		o, err := fmgGet(c, url)
+		if err != nil {
+			return fmt.Errorf("Error reading JSON RPC Request %s: %s", url, err)
+		}
+
+		if o == nil {
+			log.Printf("[WARN] resource (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		// only the attributes set in data are compared
+		var want map[string]interface{}
+		json.Unmarshal([]byte(d.Get("data").(string)), &want)
+
+		data, err := json.Marshal(fmgFilterAttributes(want, o))
+		if err != nil {
+			return fmt.Errorf("Error reading JSON RPC Request %s: %s", url, err)
+		}
+		d.Set("data", string(data))
+
+		return nil
+	}
+
+	readJSON := d.Get("read_json").(string)
+	if readJSON == "" {
+		return nil
+	}
+
+	result, err := fmgJSONRPCRequest(c, readJSON)
+	if err != nil {
+		if len(result) != 0 && fmgStatusCode(result[0]) == fmgObjectNotFound {
+			log.Printf("[WARN] resource (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading JSON RPC Request : %s", err)
+	}
+
+	d.Set("response", fmgJSONRPCResponse(result))
+
+	// the attributes set in the data of json_content and changed outside
+	// Terraform are written back to json_content so that it is sent again
+	if content := fmgJSONRPCDrift(d.Get("json_content").(string), result); content != "" {
+		d.Set("json_content", content)
+	}
+
 	return nil
 }
 
+// fmgJSONRPCDrift returns the request s with the data of its params replaced
+// with the attributes read in the result of the same index, only the
+// attributes set in the data being compared, or "" if they are the same
+func fmgJSONRPCDrift(s string, result []map[string]interface{}) string {
+	var input map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &input); err != nil {
+		return ""
+	}
+	params, _ := input["params"].([]interface{})
+
+	drift := false
+	for i, p := range params {
+		p, _ := p.(map[string]interface{})
+		want, _ := p["data"].(map[string]interface{})
+		if want == nil || i >= len(result) {
+			continue
+		}
+		got, _ := result[i]["data"].(map[string]interface{})
+		if got == nil {
+			continue
+		}
+
+		if read := fmgFilterAttributes(want, got); !reflect.DeepEqual(read, want) {
+			p["data"] = read
+			drift = true
+		}
+	}
+
+	if !drift {
+		return ""
+	}
+
+	t, err := json.MarshalIndent(input, "", "  ")
+	if err != nil {
+		return ""
+	}
+
+	return string(t)
+}
+
 func deleteFMGJSONRPCRequest(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("deleteFMGJSONRPCRequest")()
+
+	var result []map[string]interface{}
+	var err error
+
+	if deleteJSON := d.Get("delete_json").(string); deleteJSON != "" {
+		result, err = fmgJSONRPCRequest(c, deleteJSON)
+	} else if url := d.Get("url").(string); url != "" {
+		result, err = fmgCall(c, "delete", map[string]interface{}{
+			"url": url,
+		})
+	}
+
+	if err != nil && (len(result) == 0 || fmgStatusCode(result[0]) != fmgObjectNotFound) {
+		return fmt.Errorf("Error deleting JSON RPC Request : %s", err)
+	}
+
 	d.SetId("")
 
 	return nil
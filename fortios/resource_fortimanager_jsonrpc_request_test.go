@@ -0,0 +1,69 @@
+package fortios
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestFMGJSONRPCDrift(t *testing.T) {
+	content := `{
+  "method": "add",
+  "params": [
+    {
+      "url": "/pm/config/adom/root/obj/firewall/address",
+      "data": {"name": "server1", "subnet": ["10.1.0.10", "255.255.255.255"], "comment": "managed"}
+    }
+  ]
+}`
+
+	cases := []struct {
+		name string
+		read map[string]interface{}
+		want map[string]interface{}
+	}{
+		{
+			name: "same",
+			read: map[string]interface{}{"name": "server1", "subnet": []interface{}{"10.1.0.10", "255.255.255.255"}, "comment": "managed", "color": 1.0},
+		},
+		{
+			name: "attribute changed",
+			read: map[string]interface{}{"name": "server1", "subnet": []interface{}{"10.1.0.10", "255.255.255.255"}, "comment": "changed", "color": 1.0},
+			want: map[string]interface{}{"name": "server1", "subnet": []interface{}{"10.1.0.10", "255.255.255.255"}, "comment": "changed"},
+		},
+		{
+			name: "list changed",
+			read: map[string]interface{}{"name": "server1", "subnet": []interface{}{"10.1.0.11", "255.255.255.255"}, "comment": "managed"},
+			want: map[string]interface{}{"name": "server1", "subnet": []interface{}{"10.1.0.11", "255.255.255.255"}, "comment": "managed"},
+		},
+		{
+			name: "no data read",
+		},
+	}
+
+	for _, c := range cases {
+		result := []map[string]interface{}{{"status": map[string]interface{}{"code": 0.0, "message": "OK"}}}
+		if c.read != nil {
+			result[0]["data"] = c.read
+		}
+
+		got := fmgJSONRPCDrift(content, result)
+		if c.want == nil {
+			if got != "" {
+				t.Errorf("%s: fmgJSONRPCDrift() = %s, want no drift", c.name, got)
+			}
+			continue
+		}
+
+		var request struct {
+			Params []map[string]interface{} `json:"params"`
+		}
+		if err := json.Unmarshal([]byte(got), &request); err != nil || len(request.Params) != 1 {
+			t.Errorf("%s: fmgJSONRPCDrift() = %q, want a request", c.name, got)
+			continue
+		}
+		if !reflect.DeepEqual(request.Params[0]["data"], interface{}(c.want)) {
+			t.Errorf("%s: fmgJSONRPCDrift() data = %v, want %v", c.name, request.Params[0]["data"], c.want)
+		}
+	}
+}
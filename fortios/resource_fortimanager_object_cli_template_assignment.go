@@ -0,0 +1,183 @@
+package fortios
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// A CLI template or template group is assigned to the devices and device
+// groups of its scope member. The other members of the scope are left
+// untouched.
+
+func resourceFortimanagerObjectCLITemplateAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: createFMGObjectCLITemplateAssignment,
+		Read:   readFMGObjectCLITemplateAssignment,
+		Delete: deleteFMGObjectCLITemplateAssignment,
+
+		Importer: &schema.ResourceImporter{
+			State: importFMGObjectCLITemplateAssignment,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"template": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"template_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "template",
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"template", "template-group",
+				}, false),
+			},
+			"target": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vdom": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"adom": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "root",
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// fmgCLITemplateAssignmentKey returns the template type, the template, the
+// target and the vdom of the ID id, as template_type/template/target or
+// template_type/template/target/vdom
+func fmgCLITemplateAssignmentKey(id string) (string, string, string, string) {
+	l := strings.Split(id, "/")
+	if len(l) < 3 || len(l) > 4 || (l[0] != "template" && l[0] != "template-group") {
+		return "", "", "", ""
+	}
+
+	if len(l) == 3 {
+		return l[0], l[1], l[2], ""
+	}
+
+	return l[0], l[1], l[2], l[3]
+}
+
+// fmgCLITemplateMember returns the scope member of the assignment in d
+func fmgCLITemplateMember(d *schema.ResourceData) map[string]interface{} {
+	member := map[string]interface{}{
+		"name": d.Get("target").(string),
+	}
+	if vdom := d.Get("vdom").(string); vdom != "" {
+		member["vdom"] = vdom
+	}
+
+	return member
+}
+
+func createFMGObjectCLITemplateAssignment(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("createFMGObjectCLITemplateAssignment")()
+
+	adom := d.Get("adom").(string)
+	ttype := d.Get("template_type").(string)
+	template := d.Get("template").(string)
+
+	_, err := fmgCall(c, "add", map[string]interface{}{
+		"url":  fmgObjectURL(adom, "cli/"+ttype, template) + "/scope member",
+		"data": []interface{}{fmgCLITemplateMember(d)},
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating Object CLI Template Assignment: %s", err)
+	}
+
+	id := ttype + "/" + template + "/" + d.Get("target").(string)
+	if vdom := d.Get("vdom").(string); vdom != "" {
+		id += "/" + vdom
+	}
+	d.SetId(id)
+
+	return readFMGObjectCLITemplateAssignment(d, m)
+}
+
+func readFMGObjectCLITemplateAssignment(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("readFMGObjectCLITemplateAssignment")()
+
+	ttype, template, target, vdom := fmgCLITemplateAssignmentKey(d.Id())
+
+	data, err := fmgReadObject(c, d.Get("adom").(string), "cli/"+ttype, template)
+	if err != nil {
+		return fmt.Errorf("Error reading Object CLI Template Assignment: %s", err)
+	}
+
+	if data != nil {
+		for _, s := range fmgScopeMembers(data, "scope member") {
+			if fmgString(s["name"]) == target && fmgString(s["vdom"]) == vdom {
+				d.Set("template_type", ttype)
+				d.Set("template", template)
+				d.Set("target", target)
+				d.Set("vdom", vdom)
+				return nil
+			}
+		}
+	}
+
+	log.Printf("[WARN] resource (%s) not found, removing from state", d.Id())
+	d.SetId("")
+
+	return nil
+}
+
+func deleteFMGObjectCLITemplateAssignment(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("deleteFMGObjectCLITemplateAssignment")()
+
+	adom := d.Get("adom").(string)
+
+	result, err := fmgCall(c, "delete", map[string]interface{}{
+		"url":  fmgObjectURL(adom, "cli/"+d.Get("template_type").(string), d.Get("template").(string)) + "/scope member",
+		"data": []interface{}{fmgCLITemplateMember(d)},
+	})
+	if err != nil && (len(result) == 0 || fmgStatusCode(result[0]) != fmgObjectNotFound) {
+		return fmt.Errorf("Error deleting Object CLI Template Assignment: %s", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// importFMGObjectCLITemplateAssignment accepts the import IDs
+// template_type/template/target[/vdom], optionally prefixed with the ADOM
+func importFMGObjectCLITemplateAssignment(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+
+	adom := "root"
+	if t, _, _, _ := fmgCLITemplateAssignmentKey(id); t == "" {
+		if i := strings.Index(id, "/"); i > 0 {
+			adom = id[:i]
+			id = id[i+1:]
+		}
+	}
+
+	if t, _, _, _ := fmgCLITemplateAssignmentKey(id); t == "" {
+		return nil, fmt.Errorf("Error importing Object CLI Template Assignment: the import ID should be [adom/]template_type/template/target[/vdom], got %s", d.Id())
+	}
+
+	d.SetId(id)
+	d.Set("adom", adom)
+
+	return []*schema.ResourceData{d}, nil
+}
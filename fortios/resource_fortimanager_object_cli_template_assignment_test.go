@@ -0,0 +1,116 @@
+package fortios
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFortiManagerObjectCLITemplateAssignment(t *testing.T) {
+	name := "fmg-cli-template" + acctest.RandString(12)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckFortiManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFMGObjectCLITemplateAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFortiManagerObjectCLITemplateAssignmentConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFortiManagerObjectCLITemplateAssignmentExists("fortios_fmg_object_cli_template_assignment.test1"),
+					resource.TestCheckResourceAttr("fortios_fmg_object_cli_template_assignment.test1", "template", name),
+					resource.TestCheckResourceAttr("fortios_fmg_object_cli_template_assignment.test1", "template_type", "template"),
+					resource.TestCheckResourceAttr("fortios_fmg_object_cli_template_assignment.test1", "target", "myfirewall"),
+					resource.TestCheckResourceAttr("fortios_fmg_object_cli_template_assignment.test1", "vdom", "root"),
+				),
+			},
+			{
+				ResourceName:      "fortios_fmg_object_cli_template_assignment.test1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFMGCLITemplateAssigned(id string) (bool, error) {
+	c := testAccProvider.Meta().(*FortiClient).ClientFortimanager
+
+	ttype, template, target, vdom := fmgCLITemplateAssignmentKey(id)
+	o, err := fmgReadObject(c, "root", "cli/"+ttype, template)
+	if err != nil || o == nil {
+		return false, err
+	}
+
+	for _, s := range fmgScopeMembers(o, "scope member") {
+		if fmgString(s["name"]) == target && fmgString(s["vdom"]) == vdom {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func testAccCheckFortiManagerObjectCLITemplateAssignmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found Object CLI Template Assignment: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Object CLI Template Assignment is set")
+		}
+
+		assigned, err := testAccFMGCLITemplateAssigned(rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("Error reading Object CLI Template Assignment: %s", err)
+		}
+
+		if !assigned {
+			return fmt.Errorf("Error creating Object CLI Template Assignment: %s", n)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFMGObjectCLITemplateAssignmentDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fortios_fmg_object_cli_template_assignment" {
+			continue
+		}
+
+		assigned, err := testAccFMGCLITemplateAssigned(rs.Primary.ID)
+
+		if err == nil && assigned {
+			return fmt.Errorf("Error Object CLI Template Assignment %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func testAccFortiManagerObjectCLITemplateAssignmentConfig(name string) string {
+	return fmt.Sprintf(`
+resource "fortios_fmg_jsonrpc_request" "test1" {
+    url = "/pm/config/adom/root/obj/cli/template/%[1]s"
+    data = jsonencode({
+        name = "%[1]s"
+        type = "cli"
+        script = "config system global\n    set admintimeout 30\nend"
+    })
+}
+
+resource "fortios_fmg_object_cli_template_assignment" "test1" {
+    template = jsondecode(fortios_fmg_jsonrpc_request.test1.data).name
+    target = "myfirewall"
+    vdom = "root"
+}
+`, name)
+}
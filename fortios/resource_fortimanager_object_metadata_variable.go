@@ -0,0 +1,101 @@
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fmgObjectMetadataVariable is a metadata variable of the ADOM, its value for
+// each device is managed by fortios_fmg_object_metadata_variable_mapping
+var fmgObjectMetadataVariable = fmgObject{
+	path: "fmg/variable",
+	attrs: map[string]string{
+		"name":        "name",
+		"description": "description",
+		"value":       "value",
+	},
+}
+
+func resourceFortimanagerObjectMetadataVariable() *schema.Resource {
+	return &schema.Resource{
+		Create: createFMGObjectMetadataVariable,
+		Read:   readFMGObjectMetadataVariable,
+		Update: updateFMGObjectMetadataVariable,
+		Delete: deleteFMGObjectMetadataVariable,
+
+		Importer: &schema.ResourceImporter{
+			State: fmgObjectImportState(fmgObjectMetadataVariable),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"value": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"adom": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "root",
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func createFMGObjectMetadataVariable(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("createFMGObjectMetadataVariable")()
+
+	err := createFMGObject(d, m, fmgObjectMetadataVariable)
+	if err != nil {
+		return fmt.Errorf("Error creating Object Metadata Variable: %s", err)
+	}
+
+	return readFMGObjectMetadataVariable(d, m)
+}
+
+func readFMGObjectMetadataVariable(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("readFMGObjectMetadataVariable")()
+
+	err := readFMGObject(d, m, fmgObjectMetadataVariable)
+	if err != nil {
+		return fmt.Errorf("Error reading Object Metadata Variable: %s", err)
+	}
+
+	return nil
+}
+
+func updateFMGObjectMetadataVariable(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("updateFMGObjectMetadataVariable")()
+
+	err := updateFMGObject(d, m, fmgObjectMetadataVariable)
+	if err != nil {
+		return fmt.Errorf("Error updating Object Metadata Variable: %s", err)
+	}
+
+	return readFMGObjectMetadataVariable(d, m)
+}
+
+func deleteFMGObjectMetadataVariable(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("deleteFMGObjectMetadataVariable")()
+
+	err := deleteFMGObject(d, m, fmgObjectMetadataVariable)
+	if err != nil {
+		return fmt.Errorf("Error deleting Object Metadata Variable: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,242 @@
+package fortios
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	fmgclient "github.com/fortinetdev/forti-sdk-go/fortimanager/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The values of a metadata variable for each device are the dynamic mappings
+// of the variable, which are read and written as a whole. The other values of
+// the variable are left untouched.
+
+func resourceFortimanagerObjectMetadataVariableMapping() *schema.Resource {
+	return &schema.Resource{
+		Create: createFMGObjectMetadataVariableMapping,
+		Read:   readFMGObjectMetadataVariableMapping,
+		Update: createFMGObjectMetadataVariableMapping,
+		Delete: deleteFMGObjectMetadataVariableMapping,
+
+		Importer: &schema.ResourceImporter{
+			State: importFMGObjectMetadataVariableMapping,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"variable": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"device": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vdom": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "global",
+				ForceNew: true,
+			},
+			"value": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"adom": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "root",
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// fmgMetadataVariableMappingKey returns the variable, the device and the vdom
+// of the ID id, as variable/device/vdom
+func fmgMetadataVariableMappingKey(id string) (string, string, string) {
+	l := strings.Split(id, "/")
+	if len(l) != 3 {
+		return "", "", ""
+	}
+
+	return l[0], l[1], l[2]
+}
+
+// fmgMappingHasScope tells whether the dynamic mapping o applies to the vdom
+// of the device
+func fmgMappingHasScope(o map[string]interface{}, device, vdom string) bool {
+	for _, s := range fmgScopeMembers(o, "_scope") {
+		if fmgString(s["name"]) == device && fmgString(s["vdom"]) == vdom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fmgMetadataVariableMappings reads the dynamic mappings of the metadata
+// variable, found is false if the variable doesn't exist
+func fmgMetadataVariableMappings(c *fmgclient.FmgSDKClient, adom, variable string) ([]map[string]interface{}, bool, error) {
+	data, err := fmgReadObject(c, adom, fmgObjectMetadataVariable.path, variable)
+	if err != nil || data == nil {
+		return nil, false, err
+	}
+
+	l, _ := data["dynamic_mapping"].([]interface{})
+
+	mappings := make([]map[string]interface{}, 0, len(l))
+	for _, i := range l {
+		if o, ok := i.(map[string]interface{}); ok {
+			mappings = append(mappings, o)
+		}
+	}
+
+	return mappings, true, nil
+}
+
+// setFMGMetadataVariableMapping sets the value of the metadata variable for
+// the vdom of the device, or removes it if value is nil
+func setFMGMetadataVariableMapping(d *schema.ResourceData, m interface{}, value interface{}) error {
+	f := m.(*FortiClient)
+	c := f.ClientFortimanager
+
+	adom := d.Get("adom").(string)
+	variable := d.Get("variable").(string)
+	device := d.Get("device").(string)
+	vdom := d.Get("vdom").(string)
+
+	f.fmgDynamicMappingLock.Lock()
+	defer f.fmgDynamicMappingLock.Unlock()
+
+	mappings, found, err := fmgMetadataVariableMappings(c, adom, variable)
+	if err != nil {
+		return err
+	}
+	if !found {
+		if value == nil {
+			return nil
+		}
+		return fmt.Errorf("metadata variable %s not found in ADOM %s", variable, adom)
+	}
+
+	// the device is taken out of the mappings shared with other devices
+	l := make([]interface{}, 0, len(mappings)+1)
+	for _, o := range mappings {
+		if !fmgMappingHasScope(o, device, vdom) {
+			l = append(l, o)
+			continue
+		}
+
+		scope := []interface{}{}
+		for _, s := range fmgScopeMembers(o, "_scope") {
+			if fmgString(s["name"]) != device || fmgString(s["vdom"]) != vdom {
+				scope = append(scope, s)
+			}
+		}
+		if len(scope) != 0 {
+			o["_scope"] = scope
+			l = append(l, o)
+		}
+	}
+
+	if value != nil {
+		l = append(l, map[string]interface{}{
+			"_scope": []interface{}{
+				map[string]interface{}{
+					"name": device,
+					"vdom": vdom,
+				},
+			},
+			"value": value,
+		})
+	}
+
+	_, err = fmgCall(c, "update", map[string]interface{}{
+		"url": fmgObjectURL(adom, fmgObjectMetadataVariable.path, variable),
+		"data": map[string]interface{}{
+			"dynamic_mapping": l,
+		},
+	})
+
+	return err
+}
+
+func createFMGObjectMetadataVariableMapping(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("createFMGObjectMetadataVariableMapping")()
+
+	err := setFMGMetadataVariableMapping(d, m, d.Get("value").(string))
+	if err != nil {
+		return fmt.Errorf("Error setting Object Metadata Variable Mapping: %s", err)
+	}
+
+	d.SetId(d.Get("variable").(string) + "/" + d.Get("device").(string) + "/" + d.Get("vdom").(string))
+
+	return readFMGObjectMetadataVariableMapping(d, m)
+}
+
+func readFMGObjectMetadataVariableMapping(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("readFMGObjectMetadataVariableMapping")()
+
+	variable, device, vdom := fmgMetadataVariableMappingKey(d.Id())
+
+	mappings, _, err := fmgMetadataVariableMappings(c, d.Get("adom").(string), variable)
+	if err != nil {
+		return fmt.Errorf("Error reading Object Metadata Variable Mapping: %s", err)
+	}
+
+	for _, o := range mappings {
+		if fmgMappingHasScope(o, device, vdom) {
+			d.Set("variable", variable)
+			d.Set("device", device)
+			d.Set("vdom", vdom)
+			d.Set("value", fmgString(o["value"]))
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] resource (%s) not found, removing from state", d.Id())
+	d.SetId("")
+
+	return nil
+}
+
+func deleteFMGObjectMetadataVariableMapping(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("deleteFMGObjectMetadataVariableMapping")()
+
+	err := setFMGMetadataVariableMapping(d, m, nil)
+	if err != nil {
+		return fmt.Errorf("Error deleting Object Metadata Variable Mapping: %s", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// importFMGObjectMetadataVariableMapping accepts the import IDs
+// variable/device/vdom and adom/variable/device/vdom
+func importFMGObjectMetadataVariableMapping(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+
+	adom := "root"
+	if l := strings.Split(id, "/"); len(l) == 4 {
+		adom = l[0]
+		id = strings.Join(l[1:], "/")
+	}
+
+	if v, _, _ := fmgMetadataVariableMappingKey(id); v == "" {
+		return nil, fmt.Errorf("Error importing Object Metadata Variable Mapping: the import ID should be variable/device/vdom or adom/variable/device/vdom, got %s", d.Id())
+	}
+
+	d.SetId(id)
+	d.Set("adom", adom)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,113 @@
+package fortios
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFortiManagerObjectMetadataVariableMapping(t *testing.T) {
+	name := "fmg-metadata-variable" + acctest.RandString(12)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckFortiManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFMGObjectMetadataVariableMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFortiManagerObjectMetadataVariableMappingConfig(name, "10.0.1.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFortiManagerObjectMetadataVariableMappingExists("fortios_fmg_object_metadata_variable_mapping.test1"),
+					resource.TestCheckResourceAttr("fortios_fmg_object_metadata_variable_mapping.test1", "variable", name),
+					resource.TestCheckResourceAttr("fortios_fmg_object_metadata_variable_mapping.test1", "device", "myfirewall"),
+					resource.TestCheckResourceAttr("fortios_fmg_object_metadata_variable_mapping.test1", "vdom", "global"),
+					resource.TestCheckResourceAttr("fortios_fmg_object_metadata_variable_mapping.test1", "value", "10.0.1.1"),
+				),
+			},
+			{
+				Config: testAccFortiManagerObjectMetadataVariableMappingConfig(name, "10.0.1.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFortiManagerObjectMetadataVariableMappingExists("fortios_fmg_object_metadata_variable_mapping.test1"),
+					resource.TestCheckResourceAttr("fortios_fmg_object_metadata_variable_mapping.test1", "value", "10.0.1.2"),
+				),
+			},
+			{
+				ResourceName:      "fortios_fmg_object_metadata_variable_mapping.test1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFortiManagerObjectMetadataVariableMappingExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found Object Metadata Variable Mapping: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Object Metadata Variable Mapping is set")
+		}
+
+		c := testAccProvider.Meta().(*FortiClient).ClientFortimanager
+
+		variable, device, vdom := fmgMetadataVariableMappingKey(rs.Primary.ID)
+		l, _, err := fmgMetadataVariableMappings(c, "root", variable)
+
+		if err != nil {
+			return fmt.Errorf("Error reading Object Metadata Variable Mapping: %s", err)
+		}
+
+		for _, o := range l {
+			if fmgMappingHasScope(o, device, vdom) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Error creating Object Metadata Variable Mapping: %s", n)
+	}
+}
+
+func testAccCheckFMGObjectMetadataVariableMappingDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*FortiClient).ClientFortimanager
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fortios_fmg_object_metadata_variable_mapping" {
+			continue
+		}
+
+		variable, device, vdom := fmgMetadataVariableMappingKey(rs.Primary.ID)
+		l, _, err := fmgMetadataVariableMappings(c, "root", variable)
+
+		if err == nil {
+			for _, o := range l {
+				if fmgMappingHasScope(o, device, vdom) {
+					return fmt.Errorf("Error Object Metadata Variable Mapping %s still exists", rs.Primary.ID)
+				}
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func testAccFortiManagerObjectMetadataVariableMappingConfig(name, value string) string {
+	return fmt.Sprintf(`
+resource "fortios_fmg_object_metadata_variable" "test1" {
+    name = "%[1]s"
+    value = "10.0.0.1"
+}
+
+resource "fortios_fmg_object_metadata_variable_mapping" "test1" {
+    variable = fortios_fmg_object_metadata_variable.test1.name
+    device = "myfirewall"
+    value = "%[2]s"
+}
+`, name, value)
+}
@@ -0,0 +1,96 @@
+package fortios
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFortiManagerObjectMetadataVariable(t *testing.T) {
+	name := "fmg-metadata-variable" + acctest.RandString(12)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckFortiManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFMGObjectMetadataVariableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFortiManagerObjectMetadataVariableConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFortiManagerObjectMetadataVariableExists("fortios_fmg_object_metadata_variable.test1"),
+					resource.TestCheckResourceAttr("fortios_fmg_object_metadata_variable.test1", "name", name),
+					resource.TestCheckResourceAttr("fortios_fmg_object_metadata_variable.test1", "description", "test metadata variable"),
+					resource.TestCheckResourceAttr("fortios_fmg_object_metadata_variable.test1", "value", "10.0.0.1"),
+				),
+			},
+			{
+				ResourceName:      "fortios_fmg_object_metadata_variable.test1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFortiManagerObjectMetadataVariableExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found Object Metadata Variable: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Object Metadata Variable is set")
+		}
+
+		c := testAccProvider.Meta().(*FortiClient).ClientFortimanager
+
+		i := rs.Primary.ID
+		o, err := fmgReadObject(c, "root", fmgObjectMetadataVariable.path, i)
+
+		if err != nil {
+			return fmt.Errorf("Error reading Object Metadata Variable: %s", err)
+		}
+
+		if o == nil {
+			return fmt.Errorf("Error creating Object Metadata Variable: %s", n)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFMGObjectMetadataVariableDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*FortiClient).ClientFortimanager
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fortios_fmg_object_metadata_variable" {
+			continue
+		}
+
+		i := rs.Primary.ID
+		o, err := fmgReadObject(c, "root", fmgObjectMetadataVariable.path, i)
+
+		if err == nil {
+			if o != nil {
+				return fmt.Errorf("Error Object Metadata Variable %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func testAccFortiManagerObjectMetadataVariableConfig(name string) string {
+	return fmt.Sprintf(`
+resource "fortios_fmg_object_metadata_variable" "test1" {
+    name = "%s"
+    description = "test metadata variable"
+    value = "10.0.0.1"
+}
+`, name)
+}
@@ -0,0 +1,128 @@
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var fmgObjectZone = fmgObject{
+	path: "dynamic/interface",
+	attrs: map[string]string{
+		"name":            "name",
+		"description":     "description",
+		"single_intf":     "single-intf",
+		"zone_only":       "zone-only",
+		"default_mapping": "default-mapping",
+		"defmap_intf":     "defmap-intf",
+	},
+}
+
+func resourceFortimanagerObjectZone() *schema.Resource {
+	return &schema.Resource{
+		Create: createFMGObjectZone,
+		Read:   readFMGObjectZone,
+		Update: updateFMGObjectZone,
+		Delete: deleteFMGObjectZone,
+
+		Importer: &schema.ResourceImporter{
+			State: fmgObjectImportState(fmgObjectZone),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"single_intf": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"enable", "disable",
+				}, false),
+			},
+			"zone_only": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"enable", "disable",
+				}, false),
+			},
+			"default_mapping": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"enable", "disable",
+				}, false),
+			},
+			"defmap_intf": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"adom": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "root",
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func createFMGObjectZone(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("createFMGObjectZone")()
+
+	err := createFMGObject(d, m, fmgObjectZone)
+	if err != nil {
+		return fmt.Errorf("Error creating Object Zone: %s", err)
+	}
+
+	return readFMGObjectZone(d, m)
+}
+
+func readFMGObjectZone(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("readFMGObjectZone")()
+
+	err := readFMGObject(d, m, fmgObjectZone)
+	if err != nil {
+		return fmt.Errorf("Error reading Object Zone: %s", err)
+	}
+
+	return nil
+}
+
+func updateFMGObjectZone(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("updateFMGObjectZone")()
+
+	err := updateFMGObject(d, m, fmgObjectZone)
+	if err != nil {
+		return fmt.Errorf("Error updating Object Zone: %s", err)
+	}
+
+	return readFMGObjectZone(d, m)
+}
+
+func deleteFMGObjectZone(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("deleteFMGObjectZone")()
+
+	err := deleteFMGObject(d, m, fmgObjectZone)
+	if err != nil {
+		return fmt.Errorf("Error deleting Object Zone: %s", err)
+	}
+
+	return nil
+}
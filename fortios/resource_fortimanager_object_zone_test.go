@@ -0,0 +1,98 @@
+package fortios
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFortiManagerObjectZone(t *testing.T) {
+	name := "fmg-object-zone" + acctest.RandString(12)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckFortiManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFMGObjectZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFortiManagerObjectZoneConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFortiManagerObjectZoneExists("fortios_fmg_object_zone.test1"),
+					resource.TestCheckResourceAttr("fortios_fmg_object_zone.test1", "name", name),
+					resource.TestCheckResourceAttr("fortios_fmg_object_zone.test1", "description", "test obj zone"),
+					resource.TestCheckResourceAttr("fortios_fmg_object_zone.test1", "default_mapping", "enable"),
+					resource.TestCheckResourceAttr("fortios_fmg_object_zone.test1", "defmap_intf", "port2"),
+				),
+			},
+			{
+				ResourceName:      "fortios_fmg_object_zone.test1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFortiManagerObjectZoneExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found Object Zone: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Object Zone is set")
+		}
+
+		c := testAccProvider.Meta().(*FortiClient).ClientFortimanager
+
+		i := rs.Primary.ID
+		o, err := fmgReadObject(c, "root", fmgObjectZone.path, i)
+
+		if err != nil {
+			return fmt.Errorf("Error reading Object Zone: %s", err)
+		}
+
+		if o == nil {
+			return fmt.Errorf("Error creating Object Zone: %s", n)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFMGObjectZoneDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*FortiClient).ClientFortimanager
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fortios_fmg_object_zone" {
+			continue
+		}
+
+		i := rs.Primary.ID
+		o, err := fmgReadObject(c, "root", fmgObjectZone.path, i)
+
+		if err == nil {
+			if o != nil {
+				return fmt.Errorf("Error Object Zone %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func testAccFortiManagerObjectZoneConfig(name string) string {
+	return fmt.Sprintf(`
+resource "fortios_fmg_object_zone" "test1" {
+    name = "%s"
+    description = "test obj zone"
+    default_mapping = "enable"
+    defmap_intf = "port2"
+}
+`, name)
+}
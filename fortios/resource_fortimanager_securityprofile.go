@@ -0,0 +1,222 @@
+package fortios
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// fmgSecurityProfilePaths are the tables of the security profiles of each
+// type
+var fmgSecurityProfilePaths = map[string]string{
+	"antivirus":   "antivirus/profile",
+	"webfilter":   "webfilter/profile",
+	"dnsfilter":   "dnsfilter/profile",
+	"ips":         "ips/sensor",
+	"application": "application/list",
+	"emailfilter": "emailfilter/profile",
+	"file-filter": "file-filter/profile",
+	"ssl-ssh":     "firewall/ssl-ssh-profile",
+}
+
+func resourceFortimanagerSecurityProfile() *schema.Resource {
+	var types []string
+	for k := range fmgSecurityProfilePaths {
+		types = append(types, k)
+	}
+	sort.Strings(types)
+
+	return &schema.Resource{
+		Create: createFMGSecurityProfile,
+		Read:   readFMGSecurityProfile,
+		Update: updateFMGSecurityProfile,
+		Delete: deleteFMGSecurityProfile,
+
+		Importer: &schema.ResourceImporter{
+			State: importFMGSecurityProfile,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(types, false),
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"attributes": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"adom": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "root",
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func fmgSecurityProfile(t string) fmgObject {
+	return fmgObject{
+		path: fmgSecurityProfilePaths[t],
+		attrs: map[string]string{
+			"name":    "name",
+			"comment": "comment",
+		},
+	}
+}
+
+// fmgSecurityProfileData returns the attributes of the security profile of d,
+// the arguments taking precedence over attributes
+func fmgSecurityProfileData(d *schema.ResourceData) (map[string]interface{}, error) {
+	data := make(map[string]interface{})
+
+	if v, ok := d.GetOk("attributes"); ok {
+		if err := json.Unmarshal([]byte(v.(string)), &data); err != nil {
+			return nil, fmt.Errorf("attributes should be a JSON object: %v", err)
+		}
+	}
+
+	for k, v := range fmgObjectData(d, fmgSecurityProfile(d.Get("type").(string))) {
+		data[k] = v
+	}
+
+	return data, nil
+}
+
+func createFMGSecurityProfile(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("createFMGSecurityProfile")()
+
+	o := fmgSecurityProfile(d.Get("type").(string))
+
+	data, err := fmgSecurityProfileData(d)
+	if err != nil {
+		return fmt.Errorf("Error creating Security Profile: %s", err)
+	}
+
+	_, err = fmgCall(c, "add", map[string]interface{}{
+		"url":  fmgObjectURL(d.Get("adom").(string), o.path, ""),
+		"data": data,
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating Security Profile: %s", err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return readFMGSecurityProfile(d, m)
+}
+
+func readFMGSecurityProfile(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("readFMGSecurityProfile")()
+
+	o := fmgSecurityProfile(d.Get("type").(string))
+
+	data, err := fmgReadObject(c, d.Get("adom").(string), o.path, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading Security Profile: %s", err)
+	}
+
+	if data == nil {
+		log.Printf("[WARN] resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := fmgSetObject(d, o, data); err != nil {
+		return fmt.Errorf("Error reading Security Profile: %s", err)
+	}
+
+	// only the attributes set in the configuration are kept
+	if v, ok := d.GetOk("attributes"); ok {
+		var want map[string]interface{}
+		json.Unmarshal([]byte(v.(string)), &want)
+
+		attrs, err := json.Marshal(fmgFilterAttributes(want, data))
+		if err != nil {
+			return fmt.Errorf("Error reading Security Profile: %s", err)
+		}
+		d.Set("attributes", string(attrs))
+	}
+
+	return nil
+}
+
+func updateFMGSecurityProfile(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("updateFMGSecurityProfile")()
+
+	o := fmgSecurityProfile(d.Get("type").(string))
+
+	data, err := fmgSecurityProfileData(d)
+	if err != nil {
+		return fmt.Errorf("Error updating Security Profile: %s", err)
+	}
+
+	_, err = fmgCall(c, "update", map[string]interface{}{
+		"url":  fmgObjectURL(d.Get("adom").(string), o.path, d.Id()),
+		"data": data,
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating Security Profile: %s", err)
+	}
+
+	return readFMGSecurityProfile(d, m)
+}
+
+func deleteFMGSecurityProfile(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).ClientFortimanager
+	defer c.Trace("deleteFMGSecurityProfile")()
+
+	err := deleteFMGObject(d, m, fmgSecurityProfile(d.Get("type").(string)))
+	if err != nil {
+		return fmt.Errorf("Error deleting Security Profile: %s", err)
+	}
+
+	return nil
+}
+
+// importFMGSecurityProfile imports the security profiles with the ID
+// "type/name" or "adom:type/name"
+func importFMGSecurityProfile(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	typ, name := id, ""
+	if i := strings.Index(id, "/"); i >= 0 {
+		typ, name = id[:i], id[i+1:]
+	}
+
+	adom := ""
+	if i := strings.Index(typ, ":"); i >= 0 {
+		adom, typ = typ[:i+1], typ[i+1:]
+	}
+
+	if name == "" || fmgSecurityProfilePaths[typ] == "" {
+		return nil, fmt.Errorf("Error importing %s: the import ID should be type/name or adom:type/name, such as antivirus/default", id)
+	}
+
+	// the ADOM prefix is kept for fmgObjectImportState
+	d.Set("type", typ)
+	d.SetId(adom + name)
+
+	return fmgObjectImportState(fmgSecurityProfile(d.Get("type").(string)))(d, m)
+}
@@ -0,0 +1,91 @@
+package fortios
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFortiManagerSecurityProfile(t *testing.T) {
+	name := "fmg-securityprofile" + acctest.RandString(12)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckFortiManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFMGSecurityProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFortiManagerSecurityProfileConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFortiManagerSecurityProfileExists("fortios_fmg_securityprofile.test1"),
+					resource.TestCheckResourceAttr("fortios_fmg_securityprofile.test1", "name", name),
+					resource.TestCheckResourceAttr("fortios_fmg_securityprofile.test1", "type", "antivirus"),
+					resource.TestCheckResourceAttr("fortios_fmg_securityprofile.test1", "comment", "test antivirus profile"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFortiManagerSecurityProfileExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found Security Profile: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Security Profile is set")
+		}
+
+		c := testAccProvider.Meta().(*FortiClient).ClientFortimanager
+
+		i := rs.Primary.ID
+		o, err := fmgReadObject(c, "root", fmgSecurityProfilePaths[rs.Primary.Attributes["type"]], i)
+
+		if err != nil {
+			return fmt.Errorf("Error reading Security Profile: %s", err)
+		}
+
+		if o == nil {
+			return fmt.Errorf("Error creating Security Profile: %s", n)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFMGSecurityProfileDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*FortiClient).ClientFortimanager
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fortios_fmg_securityprofile" {
+			continue
+		}
+
+		i := rs.Primary.ID
+		o, err := fmgReadObject(c, "root", fmgSecurityProfilePaths[rs.Primary.Attributes["type"]], i)
+
+		if err == nil {
+			if o != nil {
+				return fmt.Errorf("Error Security Profile %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func testAccFortiManagerSecurityProfileConfig(name string) string {
+	return fmt.Sprintf(`
+resource "fortios_fmg_securityprofile" "test1" {
+    type = "antivirus"
+    name = "%s"
+    comment = "test antivirus profile"
+}
+`, name)
+}
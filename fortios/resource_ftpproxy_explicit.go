@@ -103,6 +103,8 @@ func resourceFtpProxyExplicitUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FtpProxyExplicit resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_ftpproxy_explicit", obj)
+
 	o, err := c.UpdateFtpProxyExplicit(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FtpProxyExplicit resource: %v", err)
@@ -0,0 +1,154 @@
+package fortios
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceGroupMember returns a resource managing one member of the groups of
+// the CMDB table path, such as "firewall/addrgrp", through the member
+// sub-table of the group. The other members of the group are left untouched.
+// desc names the resource in the error messages, such as
+// "FirewallAddrgrpMember".
+func resourceGroupMember(path, desc string) *schema.Resource {
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			return resourceGroupMemberCreate(d, m, path, desc)
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return resourceGroupMemberRead(d, m, path, desc)
+		},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			return resourceGroupMemberDelete(d, m, path, desc)
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: cmdbImportKeys(cmdbResource{path: path}, "group/member", func(mkey string) []string {
+				group, member := resourceGroupMemberKey(mkey)
+				if group == "" || member == "" {
+					return nil
+				}
+				return []string{group, "member", member}
+			}),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vdomparam": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"group": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 79),
+			},
+			"member": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 79),
+			},
+		},
+	}
+}
+
+func resourceGroupMemberCreate(d *schema.ResourceData, m interface{}, path, desc string) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	group := d.Get("group").(string)
+	member := d.Get("member").(string)
+
+	obj := map[string]interface{}{
+		"name": member,
+	}
+
+	_, err := cmdbCreateUpdate(c, "POST", path, []string{group, "member"}, obj, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error creating %s resource: %v", desc, err)
+	}
+
+	d.SetId(group + "/" + member)
+
+	return resourceGroupMemberRead(d, m, path, desc)
+}
+
+func resourceGroupMemberDelete(d *schema.ResourceData, m interface{}, path, desc string) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	group, member := resourceGroupMemberKey(d.Id())
+
+	err := cmdbDelete(c, path, []string{group, "member", member}, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error deleting %s resource: %v", desc, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceGroupMemberRead(d *schema.ResourceData, m interface{}, path, desc string) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	group, member := resourceGroupMemberKey(d.Id())
+
+	o, err := cmdbRead(c, path, []string{group, "member", member}, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error reading %s resource: %v", desc, err)
+	}
+
+	if o == nil {
+		log.Printf("[WARN] resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("group", group)
+	d.Set("member", member)
+
+	return nil
+}
+
+// resourceGroupMemberKey splits the ID group/member, the member name may
+// contain slashes such as in "10.0.0.0/24"
+func resourceGroupMemberKey(id string) (string, string) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 {
+		return id, ""
+	}
+
+	return parts[0], parts[1]
+}
@@ -0,0 +1,116 @@
+package fortios
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFortiOSFirewallAddrgrpMember_basic(t *testing.T) {
+	rname := acctest.RandString(8)
+	log.Printf("TestAccFortiOSFirewallAddrgrpMember_basic %s", rname)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFirewallAddrgrpMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFortiOSFirewallAddrgrpMemberConfig(rname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFortiOSFirewallAddrgrpMemberExists("fortios_firewall_addrgrp_member.trname"),
+					resource.TestCheckResourceAttr("fortios_firewall_addrgrp_member.trname", "group", rname),
+					resource.TestCheckResourceAttr("fortios_firewall_addrgrp_member.trname", "member", "var1"+rname),
+					resource.TestCheckResourceAttr("fortios_firewall_addrgrp_member.trname", "id", rname+"/var1"+rname),
+				),
+			},
+			{
+				ResourceName:      "fortios_firewall_addrgrp_member.trname",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFortiOSFirewallAddrgrpMemberExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found FirewallAddrgrpMember: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FirewallAddrgrpMember is set")
+		}
+
+		c := testAccProvider.Meta().(*FortiClient).Client
+
+		group, member := resourceGroupMemberKey(rs.Primary.ID)
+		o, err := cmdbRead(c, "firewall/addrgrp", []string{group, "member", member}, "root")
+		if err != nil {
+			return fmt.Errorf("Error reading FirewallAddrgrpMember: %s", err)
+		}
+
+		if o == nil {
+			return fmt.Errorf("Error creating FirewallAddrgrpMember: %s", n)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFirewallAddrgrpMemberDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*FortiClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fortios_firewall_addrgrp_member" {
+			continue
+		}
+
+		group, member := resourceGroupMemberKey(rs.Primary.ID)
+		o, err := cmdbRead(c, "firewall/addrgrp", []string{group, "member", member}, "root")
+		if err == nil && o != nil {
+			return fmt.Errorf("Error FirewallAddrgrpMember %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccFortiOSFirewallAddrgrpMemberConfig(name string) string {
+	return fmt.Sprintf(`
+resource "fortios_firewall_address" "trname0" {
+  name   = "var0%[1]s"
+  subnet = "12.0.0.0 255.0.0.0"
+  type   = "ipmask"
+}
+
+resource "fortios_firewall_address" "trname1" {
+  name   = "var1%[1]s"
+  subnet = "13.0.0.0 255.0.0.0"
+  type   = "ipmask"
+}
+
+resource "fortios_firewall_addrgrp" "trname" {
+  name = "%[1]s"
+
+  member {
+    name = fortios_firewall_address.trname0.name
+  }
+
+  lifecycle {
+    ignore_changes = [member]
+  }
+}
+
+resource "fortios_firewall_addrgrp_member" "trname" {
+  group  = fortios_firewall_addrgrp.trname.name
+  member = fortios_firewall_address.trname1.name
+}
+`, name)
+}
@@ -341,6 +341,8 @@ func resourceIcapProfileUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating IcapProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_icap_profile", obj)
+
 	o, err := c.UpdateIcapProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating IcapProfile resource: %v", err)
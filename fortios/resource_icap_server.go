@@ -155,6 +155,8 @@ func resourceIcapServerUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating IcapServer resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_icap_server", obj)
+
 	o, err := c.UpdateIcapServer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating IcapServer resource: %v", err)
@@ -126,6 +126,8 @@ func resourceIcapServerGroupUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating IcapServerGroup resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_icap_servergroup", obj)
+
 	o, err := c.UpdateIcapServerGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating IcapServerGroup resource: %v", err)
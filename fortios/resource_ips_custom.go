@@ -164,6 +164,8 @@ func resourceIpsCustomUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating IpsCustom resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_ips_custom", obj)
+
 	o, err := c.UpdateIpsCustom(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating IpsCustom resource: %v", err)
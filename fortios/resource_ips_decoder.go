@@ -121,6 +121,8 @@ func resourceIpsDecoderUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating IpsDecoder resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_ips_decoder", obj)
+
 	o, err := c.UpdateIpsDecoder(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating IpsDecoder resource: %v", err)
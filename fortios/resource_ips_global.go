@@ -187,6 +187,8 @@ func resourceIpsGlobalUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating IpsGlobal resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_ips_global", obj)
+
 	o, err := c.UpdateIpsGlobal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating IpsGlobal resource: %v", err)
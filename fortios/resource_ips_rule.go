@@ -190,6 +190,8 @@ func resourceIpsRuleUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating IpsRule resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_ips_rule", obj)
+
 	o, err := c.UpdateIpsRule(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating IpsRule resource: %v", err)
@@ -94,6 +94,8 @@ func resourceIpsRuleSettingsUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating IpsRuleSettings resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_ips_rulesettings", obj)
+
 	o, err := c.UpdateIpsRuleSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating IpsRuleSettings resource: %v", err)
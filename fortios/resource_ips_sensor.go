@@ -449,6 +449,8 @@ func resourceIpsSensorUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating IpsSensor resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_ips_sensor", obj)
+
 	o, err := c.UpdateIpsSensor(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating IpsSensor resource: %v", err)
@@ -80,6 +80,8 @@ func resourceIpsSettingsUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating IpsSettings resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_ips_settings", obj)
+
 	o, err := c.UpdateIpsSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating IpsSettings resource: %v", err)
@@ -115,6 +115,8 @@ func resourceIpsViewMapUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating IpsViewMap resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_ips_viewmap", obj)
+
 	o, err := c.UpdateIpsViewMap(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating IpsViewMap resource: %v", err)
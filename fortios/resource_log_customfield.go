@@ -106,6 +106,8 @@ func resourceLogCustomFieldUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating LogCustomField resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_log_customfield", obj)
+
 	o, err := c.UpdateLogCustomField(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogCustomField resource: %v", err)
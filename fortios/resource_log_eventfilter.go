@@ -142,6 +142,8 @@ func resourceLogEventfilterUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating LogEventfilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_log_eventfilter", obj)
+
 	o, err := c.UpdateLogEventfilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogEventfilter resource: %v", err)
@@ -72,6 +72,8 @@ func resourceLogGuiDisplayUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating LogGuiDisplay resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_log_guidisplay", obj)
+
 	o, err := c.UpdateLogGuiDisplay(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogGuiDisplay resource: %v", err)
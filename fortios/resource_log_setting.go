@@ -192,6 +192,8 @@ func resourceLogSettingUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating LogSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_log_setting", obj)
+
 	o, err := c.UpdateLogSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSetting resource: %v", err)
@@ -336,6 +336,8 @@ func resourceLogThreatWeightUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating LogThreatWeight resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_log_threatweight", obj)
+
 	o, err := c.UpdateLogThreatWeight(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogThreatWeight resource: %v", err)
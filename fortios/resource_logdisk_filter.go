@@ -262,6 +262,8 @@ func resourceLogDiskFilterUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating LogDiskFilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logdisk_filter", obj)
+
 	o, err := c.UpdateLogDiskFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogDiskFilter resource: %v", err)
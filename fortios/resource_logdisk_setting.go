@@ -216,6 +216,8 @@ func resourceLogDiskSettingUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating LogDiskSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logdisk_setting", obj)
+
 	o, err := c.UpdateLogDiskSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogDiskSetting resource: %v", err)
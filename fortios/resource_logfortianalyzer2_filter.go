@@ -172,6 +172,8 @@ func resourceLogFortianalyzer2FilterUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating LogFortianalyzer2Filter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logfortianalyzer2_filter", obj)
+
 	o, err := c.UpdateLogFortianalyzer2Filter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzer2Filter resource: %v", err)
@@ -172,6 +172,8 @@ func resourceLogFortianalyzer2OverrideFilterUpdate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating LogFortianalyzer2OverrideFilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logfortianalyzer2_overridefilter", obj)
+
 	o, err := c.UpdateLogFortianalyzer2OverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzer2OverrideFilter resource: %v", err)
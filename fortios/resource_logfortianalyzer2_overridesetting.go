@@ -227,6 +227,8 @@ func resourceLogFortianalyzer2OverrideSettingUpdate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error updating LogFortianalyzer2OverrideSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logfortianalyzer2_overridesetting", obj)
+
 	o, err := c.UpdateLogFortianalyzer2OverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzer2OverrideSetting resource: %v", err)
@@ -217,6 +217,8 @@ func resourceLogFortianalyzer2SettingUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating LogFortianalyzer2Setting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logfortianalyzer2_setting", obj)
+
 	o, err := c.UpdateLogFortianalyzer2Setting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzer2Setting resource: %v", err)
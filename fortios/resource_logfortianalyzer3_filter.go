@@ -172,6 +172,8 @@ func resourceLogFortianalyzer3FilterUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating LogFortianalyzer3Filter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logfortianalyzer3_filter", obj)
+
 	o, err := c.UpdateLogFortianalyzer3Filter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzer3Filter resource: %v", err)
@@ -172,6 +172,8 @@ func resourceLogFortianalyzer3OverrideFilterUpdate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating LogFortianalyzer3OverrideFilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logfortianalyzer3_overridefilter", obj)
+
 	o, err := c.UpdateLogFortianalyzer3OverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzer3OverrideFilter resource: %v", err)
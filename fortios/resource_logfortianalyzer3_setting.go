@@ -217,6 +217,8 @@ func resourceLogFortianalyzer3SettingUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating LogFortianalyzer3Setting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logfortianalyzer3_setting", obj)
+
 	o, err := c.UpdateLogFortianalyzer3Setting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzer3Setting resource: %v", err)
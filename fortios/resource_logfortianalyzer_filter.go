@@ -172,6 +172,8 @@ func resourceLogFortianalyzerFilterUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating LogFortianalyzerFilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logfortianalyzer_filter", obj)
+
 	o, err := c.UpdateLogFortianalyzerFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzerFilter resource: %v", err)
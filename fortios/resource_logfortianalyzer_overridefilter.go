@@ -172,6 +172,8 @@ func resourceLogFortianalyzerOverrideFilterUpdate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error updating LogFortianalyzerOverrideFilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logfortianalyzer_overridefilter", obj)
+
 	o, err := c.UpdateLogFortianalyzerOverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzerOverrideFilter resource: %v", err)
@@ -227,6 +227,8 @@ func resourceLogFortianalyzerOverrideSettingUpdate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating LogFortianalyzerOverrideSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logfortianalyzer_overridesetting", obj)
+
 	o, err := c.UpdateLogFortianalyzerOverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzerOverrideSetting resource: %v", err)
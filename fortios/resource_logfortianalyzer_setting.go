@@ -217,6 +217,8 @@ func resourceLogFortianalyzerSettingUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating LogFortianalyzerSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logfortianalyzer_setting", obj)
+
 	o, err := c.UpdateLogFortianalyzerSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzerSetting resource: %v", err)
@@ -152,6 +152,8 @@ func resourceLogFortianalyzerCloudFilterUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating LogFortianalyzerCloudFilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logfortianalyzercloud_filter", obj)
+
 	o, err := c.UpdateLogFortianalyzerCloudFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzerCloudFilter resource: %v", err)
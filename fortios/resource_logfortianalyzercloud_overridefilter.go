@@ -152,6 +152,8 @@ func resourceLogFortianalyzerCloudOverrideFilterUpdate(d *schema.ResourceData, m
 		return fmt.Errorf("Error updating LogFortianalyzerCloudOverrideFilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logfortianalyzercloud_overridefilter", obj)
+
 	o, err := c.UpdateLogFortianalyzerCloudOverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzerCloudOverrideFilter resource: %v", err)
@@ -62,6 +62,8 @@ func resourceLogFortianalyzerCloudOverrideSettingUpdate(d *schema.ResourceData,
 		return fmt.Errorf("Error updating LogFortianalyzerCloudOverrideSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logfortianalyzercloud_overridesetting", obj)
+
 	o, err := c.UpdateLogFortianalyzerCloudOverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzerCloudOverrideSetting resource: %v", err)
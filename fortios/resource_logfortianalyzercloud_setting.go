@@ -189,6 +189,8 @@ func resourceLogFortianalyzerCloudSettingUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating LogFortianalyzerCloudSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logfortianalyzercloud_setting", obj)
+
 	o, err := c.UpdateLogFortianalyzerCloudSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzerCloudSetting resource: %v", err)
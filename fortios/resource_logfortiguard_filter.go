@@ -172,6 +172,8 @@ func resourceLogFortiguardFilterUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating LogFortiguardFilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logfortiguard_filter", obj)
+
 	o, err := c.UpdateLogFortiguardFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortiguardFilter resource: %v", err)
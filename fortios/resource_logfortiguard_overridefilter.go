@@ -172,6 +172,8 @@ func resourceLogFortiguardOverrideFilterUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating LogFortiguardOverrideFilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logfortiguard_overridefilter", obj)
+
 	o, err := c.UpdateLogFortiguardOverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortiguardOverrideFilter resource: %v", err)
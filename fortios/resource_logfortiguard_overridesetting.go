@@ -103,6 +103,8 @@ func resourceLogFortiguardOverrideSettingUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating LogFortiguardOverrideSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logfortiguard_overridesetting", obj)
+
 	o, err := c.UpdateLogFortiguardOverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortiguardOverrideSetting resource: %v", err)
@@ -130,6 +130,8 @@ func resourceLogFortiguardSettingUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating LogFortiguardSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logfortiguard_setting", obj)
+
 	o, err := c.UpdateLogFortiguardSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortiguardSetting resource: %v", err)
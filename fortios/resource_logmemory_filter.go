@@ -257,6 +257,8 @@ func resourceLogMemoryFilterUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating LogMemoryFilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logmemory_filter", obj)
+
 	o, err := c.UpdateLogMemoryFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogMemoryFilter resource: %v", err)
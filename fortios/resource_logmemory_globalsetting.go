@@ -80,6 +80,8 @@ func resourceLogMemoryGlobalSettingUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating LogMemoryGlobalSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logmemory_globalsetting", obj)
+
 	o, err := c.UpdateLogMemoryGlobalSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogMemoryGlobalSetting resource: %v", err)
@@ -67,6 +67,8 @@ func resourceLogMemorySettingUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating LogMemorySetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logmemory_setting", obj)
+
 	o, err := c.UpdateLogMemorySetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogMemorySetting resource: %v", err)
@@ -167,6 +167,8 @@ func resourceLogNullDeviceFilterUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating LogNullDeviceFilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_lognulldevice_filter", obj)
+
 	o, err := c.UpdateLogNullDeviceFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogNullDeviceFilter resource: %v", err)
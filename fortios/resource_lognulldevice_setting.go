@@ -61,6 +61,8 @@ func resourceLogNullDeviceSettingUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating LogNullDeviceSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_lognulldevice_setting", obj)
+
 	o, err := c.UpdateLogNullDeviceSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogNullDeviceSetting resource: %v", err)
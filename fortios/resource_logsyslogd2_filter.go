@@ -167,6 +167,8 @@ func resourceLogSyslogd2FilterUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating LogSyslogd2Filter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logsyslogd2_filter", obj)
+
 	o, err := c.UpdateLogSyslogd2Filter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd2Filter resource: %v", err)
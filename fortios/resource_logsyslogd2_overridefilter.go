@@ -167,6 +167,8 @@ func resourceLogSyslogd2OverrideFilterUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating LogSyslogd2OverrideFilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logsyslogd2_overridefilter", obj)
+
 	o, err := c.UpdateLogSyslogd2OverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd2OverrideFilter resource: %v", err)
@@ -174,6 +174,8 @@ func resourceLogSyslogd2OverrideSettingUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating LogSyslogd2OverrideSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logsyslogd2_overridesetting", obj)
+
 	o, err := c.UpdateLogSyslogd2OverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd2OverrideSetting resource: %v", err)
@@ -169,6 +169,8 @@ func resourceLogSyslogd2SettingUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating LogSyslogd2Setting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logsyslogd2_setting", obj)
+
 	o, err := c.UpdateLogSyslogd2Setting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd2Setting resource: %v", err)
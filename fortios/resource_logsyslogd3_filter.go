@@ -167,6 +167,8 @@ func resourceLogSyslogd3FilterUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating LogSyslogd3Filter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logsyslogd3_filter", obj)
+
 	o, err := c.UpdateLogSyslogd3Filter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd3Filter resource: %v", err)
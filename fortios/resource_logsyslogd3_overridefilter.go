@@ -167,6 +167,8 @@ func resourceLogSyslogd3OverrideFilterUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating LogSyslogd3OverrideFilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logsyslogd3_overridefilter", obj)
+
 	o, err := c.UpdateLogSyslogd3OverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd3OverrideFilter resource: %v", err)
@@ -174,6 +174,8 @@ func resourceLogSyslogd3OverrideSettingUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating LogSyslogd3OverrideSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logsyslogd3_overridesetting", obj)
+
 	o, err := c.UpdateLogSyslogd3OverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd3OverrideSetting resource: %v", err)
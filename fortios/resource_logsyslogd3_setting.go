@@ -169,6 +169,8 @@ func resourceLogSyslogd3SettingUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating LogSyslogd3Setting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logsyslogd3_setting", obj)
+
 	o, err := c.UpdateLogSyslogd3Setting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd3Setting resource: %v", err)
@@ -167,6 +167,8 @@ func resourceLogSyslogd4FilterUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating LogSyslogd4Filter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logsyslogd4_filter", obj)
+
 	o, err := c.UpdateLogSyslogd4Filter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd4Filter resource: %v", err)
@@ -167,6 +167,8 @@ func resourceLogSyslogd4OverrideFilterUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating LogSyslogd4OverrideFilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logsyslogd4_overridefilter", obj)
+
 	o, err := c.UpdateLogSyslogd4OverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd4OverrideFilter resource: %v", err)
@@ -174,6 +174,8 @@ func resourceLogSyslogd4OverrideSettingUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating LogSyslogd4OverrideSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logsyslogd4_overridesetting", obj)
+
 	o, err := c.UpdateLogSyslogd4OverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd4OverrideSetting resource: %v", err)
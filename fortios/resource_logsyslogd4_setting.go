@@ -169,6 +169,8 @@ func resourceLogSyslogd4SettingUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating LogSyslogd4Setting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logsyslogd4_setting", obj)
+
 	o, err := c.UpdateLogSyslogd4Setting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd4Setting resource: %v", err)
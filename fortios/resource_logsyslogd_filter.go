@@ -167,6 +167,8 @@ func resourceLogSyslogdFilterUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating LogSyslogdFilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logsyslogd_filter", obj)
+
 	o, err := c.UpdateLogSyslogdFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogdFilter resource: %v", err)
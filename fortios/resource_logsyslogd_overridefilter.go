@@ -167,6 +167,8 @@ func resourceLogSyslogdOverrideFilterUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating LogSyslogdOverrideFilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logsyslogd_overridefilter", obj)
+
 	o, err := c.UpdateLogSyslogdOverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogdOverrideFilter resource: %v", err)
@@ -174,6 +174,8 @@ func resourceLogSyslogdOverrideSettingUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating LogSyslogdOverrideSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logsyslogd_overridesetting", obj)
+
 	o, err := c.UpdateLogSyslogdOverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogdOverrideSetting resource: %v", err)
@@ -169,6 +169,8 @@ func resourceLogSyslogdSettingUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating LogSyslogdSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logsyslogd_setting", obj)
+
 	o, err := c.UpdateLogSyslogdSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogdSetting resource: %v", err)
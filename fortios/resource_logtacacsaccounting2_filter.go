@@ -72,6 +72,8 @@ func resourceLogTacacsAccounting2FilterUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating LogTacacsAccounting2Filter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logtacacsaccounting2_filter", obj)
+
 	o, err := c.UpdateLogTacacsAccounting2Filter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogTacacsAccounting2Filter resource: %v", err)
@@ -73,6 +73,8 @@ func resourceLogTacacsAccounting2SettingUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating LogTacacsAccounting2Setting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logtacacsaccounting2_setting", obj)
+
 	o, err := c.UpdateLogTacacsAccounting2Setting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogTacacsAccounting2Setting resource: %v", err)
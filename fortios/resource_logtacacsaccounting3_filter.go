@@ -72,6 +72,8 @@ func resourceLogTacacsAccounting3FilterUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating LogTacacsAccounting3Filter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logtacacsaccounting3_filter", obj)
+
 	o, err := c.UpdateLogTacacsAccounting3Filter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogTacacsAccounting3Filter resource: %v", err)
@@ -73,6 +73,8 @@ func resourceLogTacacsAccounting3SettingUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating LogTacacsAccounting3Setting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logtacacsaccounting3_setting", obj)
+
 	o, err := c.UpdateLogTacacsAccounting3Setting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogTacacsAccounting3Setting resource: %v", err)
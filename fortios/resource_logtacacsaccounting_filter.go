@@ -72,6 +72,8 @@ func resourceLogTacacsAccountingFilterUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating LogTacacsAccountingFilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logtacacsaccounting_filter", obj)
+
 	o, err := c.UpdateLogTacacsAccountingFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogTacacsAccountingFilter resource: %v", err)
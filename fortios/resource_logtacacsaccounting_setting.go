@@ -73,6 +73,8 @@ func resourceLogTacacsAccountingSettingUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating LogTacacsAccountingSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logtacacsaccounting_setting", obj)
+
 	o, err := c.UpdateLogTacacsAccountingSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogTacacsAccountingSetting resource: %v", err)
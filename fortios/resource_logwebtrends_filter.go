@@ -167,6 +167,8 @@ func resourceLogWebtrendsFilterUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating LogWebtrendsFilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logwebtrends_filter", obj)
+
 	o, err := c.UpdateLogWebtrendsFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogWebtrendsFilter resource: %v", err)
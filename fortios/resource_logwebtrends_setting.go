@@ -68,6 +68,8 @@ func resourceLogWebtrendsSettingUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating LogWebtrendsSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_logwebtrends_setting", obj)
+
 	o, err := c.UpdateLogWebtrendsSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogWebtrendsSetting resource: %v", err)
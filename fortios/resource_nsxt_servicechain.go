@@ -139,6 +139,8 @@ func resourceNsxtServiceChainUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating NsxtServiceChain resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_nsxt_servicechain", obj)
+
 	o, err := c.UpdateNsxtServiceChain(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating NsxtServiceChain resource: %v", err)
@@ -68,6 +68,8 @@ func resourceNsxtSettingUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating NsxtSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_nsxt_setting", obj)
+
 	o, err := c.UpdateNsxtSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating NsxtSetting resource: %v", err)
@@ -474,6 +474,8 @@ func resourceReportChartUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating ReportChart resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_report_chart", obj)
+
 	o, err := c.UpdateReportChart(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ReportChart resource: %v", err)
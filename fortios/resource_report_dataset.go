@@ -171,6 +171,8 @@ func resourceReportDatasetUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating ReportDataset resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_report_dataset", obj)
+
 	o, err := c.UpdateReportDataset(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ReportDataset resource: %v", err)
@@ -497,6 +497,8 @@ func resourceReportLayoutUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating ReportLayout resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_report_layout", obj)
+
 	o, err := c.UpdateReportLayout(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ReportLayout resource: %v", err)
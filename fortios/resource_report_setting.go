@@ -84,6 +84,8 @@ func resourceReportSettingUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating ReportSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_report_setting", obj)
+
 	o, err := c.UpdateReportSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ReportSetting resource: %v", err)
@@ -236,6 +236,8 @@ func resourceReportStyleUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating ReportStyle resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_report_style", obj)
+
 	o, err := c.UpdateReportStyle(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ReportStyle resource: %v", err)
@@ -268,6 +268,8 @@ func resourceReportThemeUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating ReportTheme resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_report_theme", obj)
+
 	o, err := c.UpdateReportTheme(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ReportTheme resource: %v", err)
@@ -144,6 +144,8 @@ func resourceRouterAccessListUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating RouterAccessList resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_accesslist", obj)
+
 	o, err := c.UpdateRouterAccessList(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterAccessList resource: %v", err)
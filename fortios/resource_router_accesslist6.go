@@ -139,6 +139,8 @@ func resourceRouterAccessList6Update(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating RouterAccessList6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_accesslist6", obj)
+
 	o, err := c.UpdateRouterAccessList6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterAccessList6 resource: %v", err)
@@ -124,6 +124,8 @@ func resourceRouterAspathListUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating RouterAspathList resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_aspathlist", obj)
+
 	o, err := c.UpdateRouterAspathList(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterAspathList resource: %v", err)
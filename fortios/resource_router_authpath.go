@@ -105,6 +105,8 @@ func resourceRouterAuthPathUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterAuthPath resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_authpath", obj)
+
 	o, err := c.UpdateRouterAuthPath(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterAuthPath resource: %v", err)
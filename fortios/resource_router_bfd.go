@@ -133,6 +133,8 @@ func resourceRouterBfdUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterBfd resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_bfd", obj)
+
 	o, err := c.UpdateRouterBfd(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterBfd resource: %v", err)
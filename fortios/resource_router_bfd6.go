@@ -133,6 +133,8 @@ func resourceRouterBfd6Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterBfd6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_bfd6", obj)
+
 	o, err := c.UpdateRouterBfd6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterBfd6 resource: %v", err)
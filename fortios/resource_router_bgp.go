@@ -2089,6 +2089,8 @@ func resourceRouterBgpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterBgp resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_bgp", obj)
+
 	o, err := c.UpdateRouterBgp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterBgp resource: %v", err)
@@ -134,6 +134,8 @@ func resourceRouterCommunityListUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating RouterCommunityList resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_communitylist", obj)
+
 	o, err := c.UpdateRouterCommunityList(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterCommunityList resource: %v", err)
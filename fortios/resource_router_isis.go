@@ -570,6 +570,8 @@ func resourceRouterIsisUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterIsis resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_isis", obj)
+
 	o, err := c.UpdateRouterIsis(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterIsis resource: %v", err)
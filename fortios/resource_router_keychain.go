@@ -135,6 +135,8 @@ func resourceRouterKeyChainUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterKeyChain resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_keychain", obj)
+
 	o, err := c.UpdateRouterKeyChain(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterKeyChain resource: %v", err)
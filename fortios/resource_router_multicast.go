@@ -447,6 +447,8 @@ func resourceRouterMulticastUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating RouterMulticast resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_multicast", obj)
+
 	o, err := c.UpdateRouterMulticast(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterMulticast resource: %v", err)
@@ -132,6 +132,8 @@ func resourceRouterMulticast6Update(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating RouterMulticast6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_multicast6", obj)
+
 	o, err := c.UpdateRouterMulticast6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterMulticast6 resource: %v", err)
@@ -129,6 +129,8 @@ func resourceRouterMulticastFlowUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating RouterMulticastFlow resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_multicastflow", obj)
+
 	o, err := c.UpdateRouterMulticastFlow(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterMulticastFlow resource: %v", err)
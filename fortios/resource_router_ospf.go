@@ -746,6 +746,8 @@ func resourceRouterOspfUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterOspf resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_ospf", obj)
+
 	o, err := c.UpdateRouterOspf(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterOspf resource: %v", err)
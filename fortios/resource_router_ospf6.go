@@ -591,6 +591,8 @@ func resourceRouterOspf6Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterOspf6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_ospf6", obj)
+
 	o, err := c.UpdateRouterOspf6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterOspf6 resource: %v", err)
@@ -279,6 +279,8 @@ func resourceRouterPolicyUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_policy", obj)
+
 	o, err := c.UpdateRouterPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterPolicy resource: %v", err)
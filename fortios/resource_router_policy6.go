@@ -239,6 +239,8 @@ func resourceRouterPolicy6Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterPolicy6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_policy6", obj)
+
 	o, err := c.UpdateRouterPolicy6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterPolicy6 resource: %v", err)
@@ -146,6 +146,8 @@ func resourceRouterPrefixListUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating RouterPrefixList resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_prefixlist", obj)
+
 	o, err := c.UpdateRouterPrefixList(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterPrefixList resource: %v", err)
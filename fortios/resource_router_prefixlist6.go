@@ -146,6 +146,8 @@ func resourceRouterPrefixList6Update(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating RouterPrefixList6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_prefixlist6", obj)
+
 	o, err := c.UpdateRouterPrefixList6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterPrefixList6 resource: %v", err)
@@ -353,6 +353,8 @@ func resourceRouterRipUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterRip resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_rip", obj)
+
 	o, err := c.UpdateRouterRip(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterRip resource: %v", err)
@@ -335,6 +335,8 @@ func resourceRouterRipngUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterRipng resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_ripng", obj)
+
 	o, err := c.UpdateRouterRipng(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterRipng resource: %v", err)
@@ -387,6 +387,8 @@ func resourceRouterRouteMapUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterRouteMap resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_routemap", obj)
+
 	o, err := c.UpdateRouterRouteMap(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterRouteMap resource: %v", err)
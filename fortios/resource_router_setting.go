@@ -189,6 +189,8 @@ func resourceRouterSettingUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_setting", obj)
+
 	o, err := c.UpdateRouterSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterSetting resource: %v", err)
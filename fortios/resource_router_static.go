@@ -215,6 +215,8 @@ func resourceRouterStaticUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterStatic resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_static", obj)
+
 	o, err := c.UpdateRouterStatic(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterStatic resource: %v", err)
@@ -203,6 +203,8 @@ func resourceRouterStatic6Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterStatic6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_router_static6", obj)
+
 	o, err := c.UpdateRouterStatic6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterStatic6 resource: %v", err)
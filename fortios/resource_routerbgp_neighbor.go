@@ -755,6 +755,8 @@ func resourceRouterbgpNeighborUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating RouterbgpNeighbor resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_routerbgp_neighbor", obj)
+
 	o, err := c.UpdateRouterbgpNeighbor(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterbgpNeighbor resource: %v", err)
@@ -115,6 +115,8 @@ func resourceRouterbgpNetworkUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating RouterbgpNetwork resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_routerbgp_network", obj)
+
 	o, err := c.UpdateRouterbgpNetwork(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterbgpNetwork resource: %v", err)
@@ -116,6 +116,8 @@ func resourceRouterbgpNetwork6Update(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating RouterbgpNetwork6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_routerbgp_network6", obj)
+
 	o, err := c.UpdateRouterbgpNetwork6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterbgpNetwork6 resource: %v", err)
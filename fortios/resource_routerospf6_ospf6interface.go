@@ -249,6 +249,8 @@ func resourceRouterospf6Ospf6InterfaceUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating Routerospf6Ospf6Interface resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_routerospf6_ospf6interface", obj)
+
 	o, err := c.UpdateRouterospf6Ospf6Interface(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating Routerospf6Ospf6Interface resource: %v", err)
@@ -118,6 +118,8 @@ func resourceRouterospfNeighborUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating RouterospfNeighbor resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_routerospf_neighbor", obj)
+
 	o, err := c.UpdateRouterospfNeighbor(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterospfNeighbor resource: %v", err)
@@ -110,6 +110,8 @@ func resourceRouterospfNetworkUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating RouterospfNetwork resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_routerospf_network", obj)
+
 	o, err := c.UpdateRouterospfNetwork(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterospfNetwork resource: %v", err)
@@ -248,6 +248,8 @@ func resourceRouterospfOspfInterfaceUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating RouterospfOspfInterface resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_routerospf_ospfinterface", obj)
+
 	o, err := c.UpdateRouterospfOspfInterface(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterospfOspfInterface resource: %v", err)
@@ -134,6 +134,8 @@ func resourceSctpFilterProfileUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SctpFilterProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_sctpfilter_profile", obj)
+
 	o, err := c.UpdateSctpFilterProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SctpFilterProfile resource: %v", err)
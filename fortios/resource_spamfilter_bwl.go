@@ -163,6 +163,8 @@ func resourceSpamfilterBwlUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SpamfilterBwl resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_spamfilter_bwl", obj)
+
 	o, err := c.UpdateSpamfilterBwl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SpamfilterBwl resource: %v", err)
@@ -159,6 +159,8 @@ func resourceSpamfilterBwordUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SpamfilterBword resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_spamfilter_bword", obj)
+
 	o, err := c.UpdateSpamfilterBword(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SpamfilterBword resource: %v", err)
@@ -138,6 +138,8 @@ func resourceSpamfilterDnsblUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SpamfilterDnsbl resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_spamfilter_dnsbl", obj)
+
 	o, err := c.UpdateSpamfilterDnsbl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SpamfilterDnsbl resource: %v", err)
@@ -73,6 +73,8 @@ func resourceSpamfilterFortishieldUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SpamfilterFortishield resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_spamfilter_fortishield", obj)
+
 	o, err := c.UpdateSpamfilterFortishield(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SpamfilterFortishield resource: %v", err)
@@ -142,6 +142,8 @@ func resourceSpamfilterIptrustUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SpamfilterIptrust resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_spamfilter_iptrust", obj)
+
 	o, err := c.UpdateSpamfilterIptrust(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SpamfilterIptrust resource: %v", err)
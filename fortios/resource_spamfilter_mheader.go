@@ -149,6 +149,8 @@ func resourceSpamfilterMheaderUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SpamfilterMheader resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_spamfilter_mheader", obj)
+
 	o, err := c.UpdateSpamfilterMheader(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SpamfilterMheader resource: %v", err)
@@ -63,6 +63,8 @@ func resourceSpamfilterOptionsUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SpamfilterOptions resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_spamfilter_options", obj)
+
 	o, err := c.UpdateSpamfilterOptions(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SpamfilterOptions resource: %v", err)
@@ -334,6 +334,8 @@ func resourceSpamfilterProfileUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SpamfilterProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_spamfilter_profile", obj)
+
 	o, err := c.UpdateSpamfilterProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SpamfilterProfile resource: %v", err)
@@ -233,6 +233,8 @@ func resourceSshFilterProfileUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SshFilterProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_sshfilter_profile", obj)
+
 	o, err := c.UpdateSshFilterProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SshFilterProfile resource: %v", err)
@@ -85,6 +85,8 @@ func resourceSwitchController8021XSettingsUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating SwitchController8021XSettings resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_8021Xsettings", obj)
+
 	o, err := c.UpdateSwitchController8021XSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchController8021XSettings resource: %v", err)
@@ -107,6 +107,8 @@ func resourceSwitchControllerCustomCommandUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating SwitchControllerCustomCommand resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_customcommand", obj)
+
 	o, err := c.UpdateSwitchControllerCustomCommand(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerCustomCommand resource: %v", err)
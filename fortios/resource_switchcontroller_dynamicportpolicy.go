@@ -216,6 +216,8 @@ func resourceSwitchControllerDynamicPortPolicyUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating SwitchControllerDynamicPortPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_dynamicportpolicy", obj)
+
 	o, err := c.UpdateSwitchControllerDynamicPortPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerDynamicPortPolicy resource: %v", err)
@@ -201,6 +201,8 @@ func resourceSwitchControllerFlowTrackingUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating SwitchControllerFlowTracking resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_flowtracking", obj)
+
 	o, err := c.UpdateSwitchControllerFlowTracking(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerFlowTracking resource: %v", err)
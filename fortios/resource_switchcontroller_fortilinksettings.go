@@ -171,6 +171,8 @@ func resourceSwitchControllerFortilinkSettingsUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating SwitchControllerFortilinkSettings resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_fortilinksettings", obj)
+
 	o, err := c.UpdateSwitchControllerFortilinkSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerFortilinkSettings resource: %v", err)
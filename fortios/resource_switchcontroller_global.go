@@ -184,6 +184,8 @@ func resourceSwitchControllerGlobalUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SwitchControllerGlobal resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_global", obj)
+
 	o, err := c.UpdateSwitchControllerGlobal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerGlobal resource: %v", err)
@@ -247,6 +247,8 @@ func resourceSwitchControllerLldpProfileUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating SwitchControllerLldpProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_lldpprofile", obj)
+
 	o, err := c.UpdateSwitchControllerLldpProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerLldpProfile resource: %v", err)
@@ -90,6 +90,8 @@ func resourceSwitchControllerLldpSettingsUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating SwitchControllerLldpSettings resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_lldpsettings", obj)
+
 	o, err := c.UpdateSwitchControllerLldpSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerLldpSettings resource: %v", err)
@@ -376,6 +376,8 @@ func resourceSwitchControllerLocationUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating SwitchControllerLocation resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_location", obj)
+
 	o, err := c.UpdateSwitchControllerLocation(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerLocation resource: %v", err)
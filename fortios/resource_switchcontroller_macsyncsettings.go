@@ -63,6 +63,8 @@ func resourceSwitchControllerMacSyncSettingsUpdate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating SwitchControllerMacSyncSettings resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_macsyncsettings", obj)
+
 	o, err := c.UpdateSwitchControllerMacSyncSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerMacSyncSettings resource: %v", err)
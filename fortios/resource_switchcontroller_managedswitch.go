@@ -1558,6 +1558,8 @@ func resourceSwitchControllerManagedSwitchUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating SwitchControllerManagedSwitch resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_managedswitch", obj)
+
 	o, err := c.UpdateSwitchControllerManagedSwitch(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerManagedSwitch resource: %v", err)
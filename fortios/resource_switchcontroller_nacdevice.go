@@ -146,6 +146,8 @@ func resourceSwitchControllerNacDeviceUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating SwitchControllerNacDevice resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_nacdevice", obj)
+
 	o, err := c.UpdateSwitchControllerNacDevice(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerNacDevice resource: %v", err)
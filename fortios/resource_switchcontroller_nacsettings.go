@@ -128,6 +128,8 @@ func resourceSwitchControllerNacSettingsUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating SwitchControllerNacSettings resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_nacsettings", obj)
+
 	o, err := c.UpdateSwitchControllerNacSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerNacSettings resource: %v", err)
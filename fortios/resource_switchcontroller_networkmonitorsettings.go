@@ -62,6 +62,8 @@ func resourceSwitchControllerNetworkMonitorSettingsUpdate(d *schema.ResourceData
 		return fmt.Errorf("Error updating SwitchControllerNetworkMonitorSettings resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_networkmonitorsettings", obj)
+
 	o, err := c.UpdateSwitchControllerNetworkMonitorSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerNetworkMonitorSettings resource: %v", err)
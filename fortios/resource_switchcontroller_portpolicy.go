@@ -137,6 +137,8 @@ func resourceSwitchControllerPortPolicyUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating SwitchControllerPortPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_portpolicy", obj)
+
 	o, err := c.UpdateSwitchControllerPortPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerPortPolicy resource: %v", err)
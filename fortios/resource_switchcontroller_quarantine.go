@@ -105,6 +105,8 @@ func resourceSwitchControllerQuarantineUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating SwitchControllerQuarantine resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_quarantine", obj)
+
 	o, err := c.UpdateSwitchControllerQuarantine(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerQuarantine resource: %v", err)
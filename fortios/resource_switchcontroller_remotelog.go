@@ -128,6 +128,8 @@ func resourceSwitchControllerRemoteLogUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating SwitchControllerRemoteLog resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_remotelog", obj)
+
 	o, err := c.UpdateSwitchControllerRemoteLog(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerRemoteLog resource: %v", err)
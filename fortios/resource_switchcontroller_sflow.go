@@ -67,6 +67,8 @@ func resourceSwitchControllerSflowUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SwitchControllerSflow resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_sflow", obj)
+
 	o, err := c.UpdateSwitchControllerSflow(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSflow resource: %v", err)
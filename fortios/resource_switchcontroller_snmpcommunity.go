@@ -190,6 +190,8 @@ func resourceSwitchControllerSnmpCommunityUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating SwitchControllerSnmpCommunity resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_snmpcommunity", obj)
+
 	o, err := c.UpdateSwitchControllerSnmpCommunity(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSnmpCommunity resource: %v", err)
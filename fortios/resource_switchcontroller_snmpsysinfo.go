@@ -86,6 +86,8 @@ func resourceSwitchControllerSnmpSysinfoUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating SwitchControllerSnmpSysinfo resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_snmpsysinfo", obj)
+
 	o, err := c.UpdateSwitchControllerSnmpSysinfo(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSnmpSysinfo resource: %v", err)
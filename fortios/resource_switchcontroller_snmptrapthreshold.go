@@ -72,6 +72,8 @@ func resourceSwitchControllerSnmpTrapThresholdUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating SwitchControllerSnmpTrapThreshold resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_snmptrapthreshold", obj)
+
 	o, err := c.UpdateSwitchControllerSnmpTrapThreshold(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSnmpTrapThreshold resource: %v", err)
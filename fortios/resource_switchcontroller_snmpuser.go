@@ -134,6 +134,8 @@ func resourceSwitchControllerSnmpUserUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating SwitchControllerSnmpUser resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_snmpuser", obj)
+
 	o, err := c.UpdateSwitchControllerSnmpUser(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSnmpUser resource: %v", err)
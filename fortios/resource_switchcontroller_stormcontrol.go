@@ -78,6 +78,8 @@ func resourceSwitchControllerStormControlUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating SwitchControllerStormControl resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_stormcontrol", obj)
+
 	o, err := c.UpdateSwitchControllerStormControl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerStormControl resource: %v", err)
@@ -128,6 +128,8 @@ func resourceSwitchControllerStormControlPolicyUpdate(d *schema.ResourceData, m
 		return fmt.Errorf("Error updating SwitchControllerStormControlPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_stormcontrolpolicy", obj)
+
 	o, err := c.UpdateSwitchControllerStormControlPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerStormControlPolicy resource: %v", err)
@@ -115,6 +115,8 @@ func resourceSwitchControllerStpInstanceUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating SwitchControllerStpInstance resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_stpinstance", obj)
+
 	o, err := c.UpdateSwitchControllerStpInstance(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerStpInstance resource: %v", err)
@@ -104,6 +104,8 @@ func resourceSwitchControllerStpSettingsUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating SwitchControllerStpSettings resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_stpsettings", obj)
+
 	o, err := c.UpdateSwitchControllerStpSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerStpSettings resource: %v", err)
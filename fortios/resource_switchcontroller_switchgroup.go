@@ -133,6 +133,8 @@ func resourceSwitchControllerSwitchGroupUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating SwitchControllerSwitchGroup resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_switchgroup", obj)
+
 	o, err := c.UpdateSwitchControllerSwitchGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSwitchGroup resource: %v", err)
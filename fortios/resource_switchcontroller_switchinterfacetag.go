@@ -95,6 +95,8 @@ func resourceSwitchControllerSwitchInterfaceTagUpdate(d *schema.ResourceData, m
 		return fmt.Errorf("Error updating SwitchControllerSwitchInterfaceTag resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_switchinterfacetag", obj)
+
 	o, err := c.UpdateSwitchControllerSwitchInterfaceTag(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSwitchInterfaceTag resource: %v", err)
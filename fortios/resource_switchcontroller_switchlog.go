@@ -67,6 +67,8 @@ func resourceSwitchControllerSwitchLogUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating SwitchControllerSwitchLog resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_switchlog", obj)
+
 	o, err := c.UpdateSwitchControllerSwitchLog(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSwitchLog resource: %v", err)
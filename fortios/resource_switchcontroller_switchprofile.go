@@ -122,6 +122,8 @@ func resourceSwitchControllerSwitchProfileUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating SwitchControllerSwitchProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_switchprofile", obj)
+
 	o, err := c.UpdateSwitchControllerSwitchProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSwitchProfile resource: %v", err)
@@ -111,6 +111,8 @@ func resourceSwitchControllerSystemUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SwitchControllerSystem resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_system", obj)
+
 	o, err := c.UpdateSwitchControllerSystem(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSystem resource: %v", err)
@@ -144,6 +144,8 @@ func resourceSwitchControllerTrafficPolicyUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating SwitchControllerTrafficPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_trafficpolicy", obj)
+
 	o, err := c.UpdateSwitchControllerTrafficPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerTrafficPolicy resource: %v", err)
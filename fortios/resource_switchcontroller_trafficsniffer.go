@@ -158,6 +158,8 @@ func resourceSwitchControllerTrafficSnifferUpdate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error updating SwitchControllerTrafficSniffer resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_trafficsniffer", obj)
+
 	o, err := c.UpdateSwitchControllerTrafficSniffer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerTrafficSniffer resource: %v", err)
@@ -102,6 +102,8 @@ func resourceSwitchControllerVirtualPortPoolUpdate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating SwitchControllerVirtualPortPool resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_virtualportpool", obj)
+
 	o, err := c.UpdateSwitchControllerVirtualPortPool(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerVirtualPortPool resource: %v", err)
@@ -201,6 +201,8 @@ func resourceSwitchControllerVlanUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SwitchControllerVlan resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_vlan", obj)
+
 	o, err := c.UpdateSwitchControllerVlan(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerVlan resource: %v", err)
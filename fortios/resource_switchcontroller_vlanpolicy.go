@@ -157,6 +157,8 @@ func resourceSwitchControllerVlanPolicyUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating SwitchControllerVlanPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontroller_vlanpolicy", obj)
+
 	o, err := c.UpdateSwitchControllerVlanPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerVlanPolicy resource: %v", err)
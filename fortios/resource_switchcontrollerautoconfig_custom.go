@@ -120,6 +120,8 @@ func resourceSwitchControllerAutoConfigCustomUpdate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error updating SwitchControllerAutoConfigCustom resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontrollerautoconfig_custom", obj)
+
 	o, err := c.UpdateSwitchControllerAutoConfigCustom(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerAutoConfigCustom resource: %v", err)
@@ -122,6 +122,8 @@ func resourceSwitchControllerAutoConfigPolicyUpdate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error updating SwitchControllerAutoConfigPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontrollerautoconfig_policy", obj)
+
 	o, err := c.UpdateSwitchControllerAutoConfigPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerAutoConfigPolicy resource: %v", err)
@@ -122,6 +122,8 @@ func resourceSwitchControllerInitialConfigTemplateUpdate(d *schema.ResourceData,
 		return fmt.Errorf("Error updating SwitchControllerInitialConfigTemplate resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontrollerinitialconfig_template", obj)
+
 	o, err := c.UpdateSwitchControllerInitialConfigTemplate(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerInitialConfigTemplate resource: %v", err)
@@ -99,6 +99,8 @@ func resourceSwitchControllerInitialConfigVlansUpdate(d *schema.ResourceData, m
 		return fmt.Errorf("Error updating SwitchControllerInitialConfigVlans resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontrollerinitialconfig_vlans", obj)
+
 	o, err := c.UpdateSwitchControllerInitialConfigVlans(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerInitialConfigVlans resource: %v", err)
@@ -101,6 +101,8 @@ func resourceSwitchControllerPtpPolicyUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating SwitchControllerPtpPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontrollerptp_policy", obj)
+
 	o, err := c.UpdateSwitchControllerPtpPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerPtpPolicy resource: %v", err)
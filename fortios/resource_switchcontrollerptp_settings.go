@@ -62,6 +62,8 @@ func resourceSwitchControllerPtpSettingsUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating SwitchControllerPtpSettings resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontrollerptp_settings", obj)
+
 	o, err := c.UpdateSwitchControllerPtpSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerPtpSettings resource: %v", err)
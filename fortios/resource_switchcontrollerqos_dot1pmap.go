@@ -146,6 +146,8 @@ func resourceSwitchControllerQosDot1PMapUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating SwitchControllerQosDot1PMap resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontrollerqos_dot1pmap", obj)
+
 	o, err := c.UpdateSwitchControllerQosDot1PMap(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerQosDot1PMap resource: %v", err)
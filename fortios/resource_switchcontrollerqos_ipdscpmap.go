@@ -141,6 +141,8 @@ func resourceSwitchControllerQosIpDscpMapUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating SwitchControllerQosIpDscpMap resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontrollerqos_ipdscpmap", obj)
+
 	o, err := c.UpdateSwitchControllerQosIpDscpMap(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerQosIpDscpMap resource: %v", err)
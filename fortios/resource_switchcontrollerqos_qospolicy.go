@@ -118,6 +118,8 @@ func resourceSwitchControllerQosQosPolicyUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating SwitchControllerQosQosPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontrollerqos_qospolicy", obj)
+
 	o, err := c.UpdateSwitchControllerQosQosPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerQosQosPolicy resource: %v", err)
@@ -163,6 +163,8 @@ func resourceSwitchControllerQosQueuePolicyUpdate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error updating SwitchControllerQosQueuePolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontrollerqos_queuepolicy", obj)
+
 	o, err := c.UpdateSwitchControllerQosQueuePolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerQosQueuePolicy resource: %v", err)
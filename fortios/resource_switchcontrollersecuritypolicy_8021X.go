@@ -212,6 +212,8 @@ func resourceSwitchControllerSecurityPolicy8021XUpdate(d *schema.ResourceData, m
 		return fmt.Errorf("Error updating SwitchControllerSecurityPolicy8021X resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontrollersecuritypolicy_8021X", obj)
+
 	o, err := c.UpdateSwitchControllerSecurityPolicy8021X(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSecurityPolicy8021X resource: %v", err)
@@ -107,6 +107,8 @@ func resourceSwitchControllerSecurityPolicyCaptivePortalUpdate(d *schema.Resourc
 		return fmt.Errorf("Error updating SwitchControllerSecurityPolicyCaptivePortal resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontrollersecuritypolicy_captiveportal", obj)
+
 	o, err := c.UpdateSwitchControllerSecurityPolicyCaptivePortal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSecurityPolicyCaptivePortal resource: %v", err)
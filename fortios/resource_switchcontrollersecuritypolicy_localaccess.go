@@ -106,6 +106,8 @@ func resourceSwitchControllerSecurityPolicyLocalAccessUpdate(d *schema.ResourceD
 		return fmt.Errorf("Error updating SwitchControllerSecurityPolicyLocalAccess resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_switchcontrollersecuritypolicy_localaccess", obj)
+
 	o, err := c.UpdateSwitchControllerSecurityPolicyLocalAccess(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSecurityPolicyLocalAccess resource: %v", err)
@@ -134,6 +134,8 @@ func resourceSystem3GModemCustomUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating System3GModemCustom resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system3gmodem_custom", obj)
+
 	o, err := c.UpdateSystem3GModemCustom(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating System3GModemCustom resource: %v", err)
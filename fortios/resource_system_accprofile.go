@@ -386,6 +386,8 @@ func resourceSystemAccprofileUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemAccprofile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_accprofile", obj)
+
 	o, err := c.UpdateSystemAccprofile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAccprofile resource: %v", err)
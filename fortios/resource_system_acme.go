@@ -130,6 +130,8 @@ func resourceSystemAcmeUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemAcme resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_acme", obj)
+
 	o, err := c.UpdateSystemAcme(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAcme resource: %v", err)
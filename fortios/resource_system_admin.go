@@ -623,6 +623,8 @@ func resourceSystemAdminUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemAdmin resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_admin", obj)
+
 	o, err := c.UpdateSystemAdmin(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAdmin resource: %v", err)
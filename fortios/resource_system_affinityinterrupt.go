@@ -104,6 +104,8 @@ func resourceSystemAffinityInterruptUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemAffinityInterrupt resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_affinityinterrupt", obj)
+
 	o, err := c.UpdateSystemAffinityInterrupt(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAffinityInterrupt resource: %v", err)
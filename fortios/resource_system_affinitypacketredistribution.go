@@ -109,6 +109,8 @@ func resourceSystemAffinityPacketRedistributionUpdate(d *schema.ResourceData, m
 		return fmt.Errorf("Error updating SystemAffinityPacketRedistribution resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_affinitypacketredistribution", obj)
+
 	o, err := c.UpdateSystemAffinityPacketRedistribution(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAffinityPacketRedistribution resource: %v", err)
@@ -196,6 +196,8 @@ func resourceSystemAlarmUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemAlarm resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_alarm", obj)
+
 	o, err := c.UpdateSystemAlarm(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAlarm resource: %v", err)
@@ -101,6 +101,8 @@ func resourceSystemAliasUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemAlias resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_alias", obj)
+
 	o, err := c.UpdateSystemAlias(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAlias resource: %v", err)
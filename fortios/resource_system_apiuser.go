@@ -182,6 +182,8 @@ func resourceSystemApiUserUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemApiUser resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_apiuser", obj)
+
 	o, err := c.UpdateSystemApiUser(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemApiUser resource: %v", err)
@@ -107,6 +107,8 @@ func resourceSystemArpTableUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemArpTable resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_arptable", obj)
+
 	o, err := c.UpdateSystemArpTable(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemArpTable resource: %v", err)
@@ -79,6 +79,8 @@ func resourceSystemAutoInstallUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemAutoInstall resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_autoinstall", obj)
+
 	o, err := c.UpdateSystemAutoInstall(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAutoInstall resource: %v", err)
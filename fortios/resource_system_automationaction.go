@@ -449,6 +449,8 @@ func resourceSystemAutomationActionUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemAutomationAction resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_automationaction", obj)
+
 	o, err := c.UpdateSystemAutomationAction(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAutomationAction resource: %v", err)
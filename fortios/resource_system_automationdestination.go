@@ -126,6 +126,8 @@ func resourceSystemAutomationDestinationUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating SystemAutomationDestination resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_automationdestination", obj)
+
 	o, err := c.UpdateSystemAutomationDestination(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAutomationDestination resource: %v", err)
@@ -173,6 +173,8 @@ func resourceSystemAutomationStitchUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemAutomationStitch resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_automationstitch", obj)
+
 	o, err := c.UpdateSystemAutomationStitch(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAutomationStitch resource: %v", err)
@@ -252,6 +252,8 @@ func resourceSystemAutomationTriggerUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemAutomationTrigger resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_automationtrigger", obj)
+
 	o, err := c.UpdateSystemAutomationTrigger(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAutomationTrigger resource: %v", err)
@@ -130,6 +130,8 @@ func resourceSystemAutoScriptUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemAutoScript resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_autoscript", obj)
+
 	o, err := c.UpdateSystemAutoScript(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAutoScript resource: %v", err)
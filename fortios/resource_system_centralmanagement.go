@@ -204,6 +204,8 @@ func resourceSystemCentralManagementUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemCentralManagement resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_centralmanagement", obj)
+
 	o, err := c.UpdateSystemCentralManagement(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemCentralManagement resource: %v", err)
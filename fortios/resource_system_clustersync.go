@@ -248,6 +248,8 @@ func resourceSystemClusterSyncUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemClusterSync resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_clustersync", obj)
+
 	o, err := c.UpdateSystemClusterSync(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemClusterSync resource: %v", err)
@@ -82,6 +82,8 @@ func resourceSystemConsoleUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemConsole resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_console", obj)
+
 	o, err := c.UpdateSystemConsole(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemConsole resource: %v", err)
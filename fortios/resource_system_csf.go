@@ -288,6 +288,8 @@ func resourceSystemCsfUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemCsf resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_csf", obj)
+
 	o, err := c.UpdateSystemCsf(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemCsf resource: %v", err)
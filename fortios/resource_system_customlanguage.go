@@ -105,6 +105,8 @@ func resourceSystemCustomLanguageUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemCustomLanguage resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_customlanguage", obj)
+
 	o, err := c.UpdateSystemCustomLanguage(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemCustomLanguage resource: %v", err)
@@ -227,6 +227,8 @@ func resourceSystemDdnsUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemDdns resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_ddns", obj)
+
 	o, err := c.UpdateSystemDdns(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemDdns resource: %v", err)
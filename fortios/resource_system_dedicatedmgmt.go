@@ -93,6 +93,8 @@ func resourceSystemDedicatedMgmtUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemDedicatedMgmt resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_dedicatedmgmt", obj)
+
 	o, err := c.UpdateSystemDedicatedMgmt(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemDedicatedMgmt resource: %v", err)
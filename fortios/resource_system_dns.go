@@ -201,6 +201,8 @@ func resourceSystemDnsUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemDns resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_dns", obj)
+
 	o, err := c.UpdateSystemDns(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemDns resource: %v", err)
@@ -72,6 +72,8 @@ func resourceSystemDns64Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemDns64 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_dns64", obj)
+
 	o, err := c.UpdateSystemDns64(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemDns64 resource: %v", err)
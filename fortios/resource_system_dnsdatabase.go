@@ -225,6 +225,8 @@ func resourceSystemDnsDatabaseUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemDnsDatabase resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_dnsdatabase", obj)
+
 	o, err := c.UpdateSystemDnsDatabase(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemDnsDatabase resource: %v", err)
@@ -112,6 +112,8 @@ func resourceSystemDnsServerUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemDnsServer resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_dnsserver", obj)
+
 	o, err := c.UpdateSystemDnsServer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemDnsServer resource: %v", err)
@@ -106,6 +106,8 @@ func resourceSystemDscpBasedPriorityUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemDscpBasedPriority resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_dscpbasedpriority", obj)
+
 	o, err := c.UpdateSystemDscpBasedPriority(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemDscpBasedPriority resource: %v", err)
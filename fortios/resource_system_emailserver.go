@@ -133,6 +133,8 @@ func resourceSystemEmailServerUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemEmailServer resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_emailserver", obj)
+
 	o, err := c.UpdateSystemEmailServer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemEmailServer resource: %v", err)
@@ -170,6 +170,8 @@ func resourceSystemExternalResourceUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemExternalResource resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_externalresource", obj)
+
 	o, err := c.UpdateSystemExternalResource(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemExternalResource resource: %v", err)
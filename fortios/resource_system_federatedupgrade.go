@@ -134,6 +134,8 @@ func resourceSystemFederatedUpgradeUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemFederatedUpgrade resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_federatedupgrade", obj)
+
 	o, err := c.UpdateSystemFederatedUpgrade(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemFederatedUpgrade resource: %v", err)
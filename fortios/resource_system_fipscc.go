@@ -78,6 +78,8 @@ func resourceSystemFipsCcUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemFipsCc resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_fipscc", obj)
+
 	o, err := c.UpdateSystemFipsCc(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemFipsCc resource: %v", err)
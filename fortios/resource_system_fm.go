@@ -94,6 +94,8 @@ func resourceSystemFmUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemFm resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_fm", obj)
+
 	o, err := c.UpdateSystemFm(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemFm resource: %v", err)
@@ -79,6 +79,8 @@ func resourceSystemFortiaiUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemFortiai resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_fortiai", obj)
+
 	o, err := c.UpdateSystemFortiai(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemFortiai resource: %v", err)
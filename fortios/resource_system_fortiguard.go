@@ -365,6 +365,8 @@ func resourceSystemFortiguardUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemFortiguard resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_fortiguard", obj)
+
 	o, err := c.UpdateSystemFortiguard(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemFortiguard resource: %v", err)
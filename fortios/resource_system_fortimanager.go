@@ -93,6 +93,8 @@ func resourceSystemFortimanagerUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating SystemFortimanager resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_fortimanager", obj)
+
 	o, err := c.UpdateSystemFortimanager(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemFortimanager resource: %v", err)
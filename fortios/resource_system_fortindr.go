@@ -79,6 +79,8 @@ func resourceSystemFortindrUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemFortindr resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_fortindr", obj)
+
 	o, err := c.UpdateSystemFortindr(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemFortindr resource: %v", err)
@@ -111,6 +111,8 @@ func resourceSystemFortisandboxUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating SystemFortisandbox resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_fortisandbox", obj)
+
 	o, err := c.UpdateSystemFortisandbox(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemFortisandbox resource: %v", err)
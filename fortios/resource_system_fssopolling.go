@@ -79,6 +79,8 @@ func resourceSystemFssoPollingUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemFssoPolling resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_fssopolling", obj)
+
 	o, err := c.UpdateSystemFssoPolling(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemFssoPolling resource: %v", err)
@@ -85,6 +85,8 @@ func resourceSystemFtmPushUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemFtmPush resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_ftmpush", obj)
+
 	o, err := c.UpdateSystemFtmPush(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemFtmPush resource: %v", err)
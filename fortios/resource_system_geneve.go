@@ -130,6 +130,8 @@ func resourceSystemGeneveUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemGeneve resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_geneve", obj)
+
 	o, err := c.UpdateSystemGeneve(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemGeneve resource: %v", err)
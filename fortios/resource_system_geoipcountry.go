@@ -102,6 +102,8 @@ func resourceSystemGeoipCountryUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating SystemGeoipCountry resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_geoipcountry", obj)
+
 	o, err := c.UpdateSystemGeoipCountry(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemGeoipCountry resource: %v", err)
@@ -160,6 +160,8 @@ func resourceSystemGeoipOverrideUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemGeoipOverride resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_geoipoverride", obj)
+
 	o, err := c.UpdateSystemGeoipOverride(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemGeoipOverride resource: %v", err)
@@ -1383,6 +1383,8 @@ func resourceSystemGlobalUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemGlobal resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_global", obj)
+
 	o, err := c.UpdateSystemGlobal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemGlobal resource: %v", err)
@@ -182,6 +182,8 @@ func resourceSystemGreTunnelUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemGreTunnel resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_gretunnel", obj)
+
 	o, err := c.UpdateSystemGreTunnel(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemGreTunnel resource: %v", err)
@@ -660,6 +660,8 @@ func resourceSystemHaUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemHa resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_ha", obj)
+
 	o, err := c.UpdateSystemHa(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemHa resource: %v", err)
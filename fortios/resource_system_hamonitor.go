@@ -74,6 +74,8 @@ func resourceSystemHaMonitorUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemHaMonitor resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_hamonitor", obj)
+
 	o, err := c.UpdateSystemHaMonitor(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemHaMonitor resource: %v", err)
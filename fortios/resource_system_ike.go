@@ -537,6 +537,8 @@ func resourceSystemIkeUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemIke resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_ike", obj)
+
 	o, err := c.UpdateSystemIke(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemIke resource: %v", err)
@@ -1941,6 +1941,8 @@ func resourceSystemInterfaceUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemInterface resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_interface", obj)
+
 	o, err := c.UpdateSystemInterface(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemInterface resource: %v", err)
@@ -174,6 +174,8 @@ func resourceSystemIpamUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemIpam resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_ipam", obj)
+
 	o, err := c.UpdateSystemIpam(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemIpam resource: %v", err)
@@ -119,6 +119,8 @@ func resourceSystemIpipTunnelUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemIpipTunnel resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_ipiptunnel", obj)
+
 	o, err := c.UpdateSystemIpipTunnel(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemIpipTunnel resource: %v", err)
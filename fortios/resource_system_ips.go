@@ -67,6 +67,8 @@ func resourceSystemIpsUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemIps resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_ips", obj)
+
 	o, err := c.UpdateSystemIps(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemIps resource: %v", err)
@@ -120,6 +120,8 @@ func resourceSystemIpsecAggregateUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemIpsecAggregate resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_ipsecaggregate", obj)
+
 	o, err := c.UpdateSystemIpsecAggregate(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemIpsecAggregate resource: %v", err)
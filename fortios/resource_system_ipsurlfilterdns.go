@@ -105,6 +105,8 @@ func resourceSystemIpsUrlfilterDnsUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemIpsUrlfilterDns resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_ipsurlfilterdns", obj)
+
 	o, err := c.UpdateSystemIpsUrlfilterDns(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemIpsUrlfilterDns resource: %v", err)
@@ -100,6 +100,8 @@ func resourceSystemIpsUrlfilterDns6Update(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemIpsUrlfilterDns6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_ipsurlfilterdns6", obj)
+
 	o, err := c.UpdateSystemIpsUrlfilterDns6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemIpsUrlfilterDns6 resource: %v", err)
@@ -107,6 +107,8 @@ func resourceSystemIpv6NeighborCacheUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemIpv6NeighborCache resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_ipv6neighborcache", obj)
+
 	o, err := c.UpdateSystemIpv6NeighborCache(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemIpv6NeighborCache resource: %v", err)
@@ -121,6 +121,8 @@ func resourceSystemIpv6TunnelUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemIpv6Tunnel resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_ipv6tunnel", obj)
+
 	o, err := c.UpdateSystemIpv6Tunnel(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemIpv6Tunnel resource: %v", err)
@@ -330,6 +330,8 @@ func resourceSystemLinkMonitorUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemLinkMonitor resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_linkmonitor", obj)
+
 	o, err := c.UpdateSystemLinkMonitor(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemLinkMonitor resource: %v", err)
@@ -113,6 +113,8 @@ func resourceSystemLteModemUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemLteModem resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_ltemodem", obj)
+
 	o, err := c.UpdateSystemLteModem(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemLteModem resource: %v", err)
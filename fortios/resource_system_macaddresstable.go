@@ -104,6 +104,8 @@ func resourceSystemMacAddressTableUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemMacAddressTable resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_macaddresstable", obj)
+
 	o, err := c.UpdateSystemMacAddressTable(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemMacAddressTable resource: %v", err)
@@ -92,6 +92,8 @@ func resourceSystemManagementTunnelUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemManagementTunnel resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_managementtunnel", obj)
+
 	o, err := c.UpdateSystemManagementTunnel(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemManagementTunnel resource: %v", err)
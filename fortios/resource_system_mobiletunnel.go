@@ -186,6 +186,8 @@ func resourceSystemMobileTunnelUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating SystemMobileTunnel resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_mobiletunnel", obj)
+
 	o, err := c.UpdateSystemMobileTunnel(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemMobileTunnel resource: %v", err)
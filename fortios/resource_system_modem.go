@@ -308,6 +308,8 @@ func resourceSystemModemUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemModem resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_modem", obj)
+
 	o, err := c.UpdateSystemModem(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemModem resource: %v", err)
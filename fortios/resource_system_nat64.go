@@ -110,6 +110,8 @@ func resourceSystemNat64Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemNat64 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_nat64", obj)
+
 	o, err := c.UpdateSystemNat64(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemNat64 resource: %v", err)
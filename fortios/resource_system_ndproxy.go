@@ -81,6 +81,8 @@ func resourceSystemNdProxyUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemNdProxy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_ndproxy", obj)
+
 	o, err := c.UpdateSystemNdProxy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemNdProxy resource: %v", err)
@@ -108,6 +108,8 @@ func resourceSystemNetflowUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemNetflow resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_netflow", obj)
+
 	o, err := c.UpdateSystemNetflow(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemNetflow resource: %v", err)
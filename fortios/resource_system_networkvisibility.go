@@ -89,6 +89,8 @@ func resourceSystemNetworkVisibilityUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemNetworkVisibility resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_networkvisibility", obj)
+
 	o, err := c.UpdateSystemNetworkVisibility(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemNetworkVisibility resource: %v", err)
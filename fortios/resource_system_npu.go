@@ -173,6 +173,8 @@ func resourceSystemNpuUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemNpu resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_npu", obj)
+
 	o, err := c.UpdateSystemNpu(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemNpu resource: %v", err)
@@ -179,6 +179,8 @@ func resourceSystemNtpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemNtp resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_ntp", obj)
+
 	o, err := c.UpdateSystemNtp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemNtp resource: %v", err)
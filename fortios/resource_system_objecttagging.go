@@ -141,6 +141,8 @@ func resourceSystemObjectTaggingUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemObjectTagging resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_objecttagging", obj)
+
 	o, err := c.UpdateSystemObjectTagging(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemObjectTagging resource: %v", err)
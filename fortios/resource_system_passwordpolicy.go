@@ -124,6 +124,8 @@ func resourceSystemPasswordPolicyUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemPasswordPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_passwordpolicy", obj)
+
 	o, err := c.UpdateSystemPasswordPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemPasswordPolicy resource: %v", err)
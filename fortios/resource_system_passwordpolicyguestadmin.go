@@ -124,6 +124,8 @@ func resourceSystemPasswordPolicyGuestAdminUpdate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error updating SystemPasswordPolicyGuestAdmin resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_passwordpolicyguestadmin", obj)
+
 	o, err := c.UpdateSystemPasswordPolicyGuestAdmin(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemPasswordPolicyGuestAdmin resource: %v", err)
@@ -106,6 +106,8 @@ func resourceSystemPhysicalSwitchUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemPhysicalSwitch resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_physicalswitch", obj)
+
 	o, err := c.UpdateSystemPhysicalSwitch(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemPhysicalSwitch resource: %v", err)
@@ -175,6 +175,8 @@ func resourceSystemPppoeInterfaceUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemPppoeInterface resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_pppoeinterface", obj)
+
 	o, err := c.UpdateSystemPppoeInterface(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemPppoeInterface resource: %v", err)
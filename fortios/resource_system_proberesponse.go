@@ -96,6 +96,8 @@ func resourceSystemProbeResponseUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemProbeResponse resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_proberesponse", obj)
+
 	o, err := c.UpdateSystemProbeResponse(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemProbeResponse resource: %v", err)
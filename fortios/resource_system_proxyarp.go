@@ -108,6 +108,8 @@ func resourceSystemProxyArpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemProxyArp resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_proxyarp", obj)
+
 	o, err := c.UpdateSystemProxyArp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemProxyArp resource: %v", err)
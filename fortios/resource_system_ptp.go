@@ -117,6 +117,8 @@ func resourceSystemPtpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemPtp resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_ptp", obj)
+
 	o, err := c.UpdateSystemPtp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemPtp resource: %v", err)
@@ -661,6 +661,8 @@ func resourceSystemReplacemsgGroupUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemReplacemsgGroup resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_replacemsggroup", obj)
+
 	o, err := c.UpdateSystemReplacemsgGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgGroup resource: %v", err)
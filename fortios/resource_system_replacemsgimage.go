@@ -106,6 +106,8 @@ func resourceSystemReplacemsgImageUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemReplacemsgImage resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_replacemsgimage", obj)
+
 	o, err := c.UpdateSystemReplacemsgImage(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgImage resource: %v", err)
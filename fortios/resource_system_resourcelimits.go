@@ -147,6 +147,8 @@ func resourceSystemResourceLimitsUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemResourceLimits resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_resourcelimits", obj)
+
 	o, err := c.UpdateSystemResourceLimits(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemResourceLimits resource: %v", err)
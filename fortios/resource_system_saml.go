@@ -250,6 +250,8 @@ func resourceSystemSamlUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemSaml resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_saml", obj)
+
 	o, err := c.UpdateSystemSaml(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSaml resource: %v", err)
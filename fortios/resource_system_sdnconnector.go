@@ -556,6 +556,8 @@ func resourceSystemSdnConnectorUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating SystemSdnConnector resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_sdnconnector", obj)
+
 	o, err := c.UpdateSystemSdnConnector(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSdnConnector resource: %v", err)
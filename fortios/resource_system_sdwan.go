@@ -1204,6 +1204,8 @@ func resourceSystemSdwanUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemSdwan resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_sdwan", obj)
+
 	o, err := c.UpdateSystemSdwan(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSdwan resource: %v", err)
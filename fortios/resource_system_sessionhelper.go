@@ -109,6 +109,8 @@ func resourceSystemSessionHelperUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemSessionHelper resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_sessionhelper", obj)
+
 	o, err := c.UpdateSystemSessionHelper(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSessionHelper resource: %v", err)
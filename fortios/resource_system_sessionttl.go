@@ -104,6 +104,8 @@ func resourceSystemSessionTtlUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemSessionTtl resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_sessionttl", obj)
+
 	o, err := c.UpdateSystemSessionTtl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSessionTtl resource: %v", err)
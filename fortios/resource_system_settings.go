@@ -808,6 +808,8 @@ func resourceSystemSettingsUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemSettings resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_settings", obj)
+
 	o, err := c.UpdateSystemSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSettings resource: %v", err)
@@ -83,6 +83,8 @@ func resourceSystemSflowUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemSflow resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_sflow", obj)
+
 	o, err := c.UpdateSystemSflow(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSflow resource: %v", err)
@@ -126,6 +126,8 @@ func resourceSystemSitTunnelUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemSitTunnel resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_sittunnel", obj)
+
 	o, err := c.UpdateSystemSitTunnel(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSitTunnel resource: %v", err)
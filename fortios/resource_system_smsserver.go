@@ -101,6 +101,8 @@ func resourceSystemSmsServerUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemSmsServer resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_smsserver", obj)
+
 	o, err := c.UpdateSystemSmsServer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSmsServer resource: %v", err)
@@ -170,6 +170,8 @@ func resourceSystemSpeedTestScheduleUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemSpeedTestSchedule resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_speedtestschedule", obj)
+
 	o, err := c.UpdateSystemSpeedTestSchedule(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSpeedTestSchedule resource: %v", err)
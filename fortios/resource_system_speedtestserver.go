@@ -142,6 +142,8 @@ func resourceSystemSpeedTestServerUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemSpeedTestServer resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_speedtestserver", obj)
+
 	o, err := c.UpdateSystemSpeedTestServer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSpeedTestServer resource: %v", err)
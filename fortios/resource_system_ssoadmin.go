@@ -126,6 +126,8 @@ func resourceSystemSsoAdminUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemSsoAdmin resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_ssoadmin", obj)
+
 	o, err := c.UpdateSystemSsoAdmin(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSsoAdmin resource: %v", err)
@@ -115,6 +115,8 @@ func resourceSystemSsoForticloudAdminUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating SystemSsoForticloudAdmin resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_ssoforticloudadmin", obj)
+
 	o, err := c.UpdateSystemSsoForticloudAdmin(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSsoForticloudAdmin resource: %v", err)
@@ -233,6 +233,8 @@ func resourceSystemStandaloneClusterUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemStandaloneCluster resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_standalonecluster", obj)
+
 	o, err := c.UpdateSystemStandaloneCluster(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemStandaloneCluster resource: %v", err)
@@ -139,6 +139,8 @@ func resourceSystemStorageUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemStorage resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_storage", obj)
+
 	o, err := c.UpdateSystemStorage(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemStorage resource: %v", err)
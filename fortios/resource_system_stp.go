@@ -86,6 +86,8 @@ func resourceSystemStpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemStp resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_stp", obj)
+
 	o, err := c.UpdateSystemStp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemStp resource: %v", err)
@@ -166,6 +166,8 @@ func resourceSystemSwitchInterfaceUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemSwitchInterface resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_switchinterface", obj)
+
 	o, err := c.UpdateSystemSwitchInterface(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSwitchInterface resource: %v", err)
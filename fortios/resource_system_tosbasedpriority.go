@@ -106,6 +106,8 @@ func resourceSystemTosBasedPriorityUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemTosBasedPriority resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_tosbasedpriority", obj)
+
 	o, err := c.UpdateSystemTosBasedPriority(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemTosBasedPriority resource: %v", err)
@@ -117,6 +117,8 @@ func resourceSystemVdomUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemVdom resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_vdom", obj)
+
 	o, err := c.UpdateSystemVdom(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVdom resource: %v", err)
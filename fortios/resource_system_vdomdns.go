@@ -148,6 +148,8 @@ func resourceSystemVdomDnsUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemVdomDns resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_vdomdns", obj)
+
 	o, err := c.UpdateSystemVdomDns(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVdomDns resource: %v", err)
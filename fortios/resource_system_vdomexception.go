@@ -129,6 +129,8 @@ func resourceSystemVdomExceptionUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemVdomException resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_vdomexception", obj)
+
 	o, err := c.UpdateSystemVdomException(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVdomException resource: %v", err)
@@ -106,6 +106,8 @@ func resourceSystemVdomLinkUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemVdomLink resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_vdomlink", obj)
+
 	o, err := c.UpdateSystemVdomLink(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVdomLink resource: %v", err)
@@ -89,6 +89,8 @@ func resourceSystemVdomNetflowUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemVdomNetflow resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_vdomnetflow", obj)
+
 	o, err := c.UpdateSystemVdomNetflow(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVdomNetflow resource: %v", err)
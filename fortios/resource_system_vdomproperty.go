@@ -197,6 +197,8 @@ func resourceSystemVdomPropertyUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating SystemVdomProperty resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_vdomproperty", obj)
+
 	o, err := c.UpdateSystemVdomProperty(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVdomProperty resource: %v", err)
@@ -106,6 +106,8 @@ func resourceSystemVdomRadiusServerUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemVdomRadiusServer resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_vdomradiusserver", obj)
+
 	o, err := c.UpdateSystemVdomRadiusServer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVdomRadiusServer resource: %v", err)
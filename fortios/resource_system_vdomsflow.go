@@ -89,6 +89,8 @@ func resourceSystemVdomSflowUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemVdomSflow resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_vdomsflow", obj)
+
 	o, err := c.UpdateSystemVdomSflow(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVdomSflow resource: %v", err)
@@ -154,6 +154,8 @@ func resourceSystemVirtualSwitchUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemVirtualSwitch resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_virtualswitch", obj)
+
 	o, err := c.UpdateSystemVirtualSwitch(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVirtualSwitch resource: %v", err)
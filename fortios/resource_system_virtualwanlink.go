@@ -917,6 +917,8 @@ func resourceSystemVirtualWanLinkUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemVirtualWanLink resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_virtualwanlink", obj)
+
 	o, err := c.UpdateSystemVirtualWanLink(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVirtualWanLink resource: %v", err)
@@ -125,6 +125,8 @@ func resourceSystemVirtualWirePairUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemVirtualWirePair resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_virtualwirepair", obj)
+
 	o, err := c.UpdateSystemVirtualWirePair(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVirtualWirePair resource: %v", err)
@@ -117,6 +117,8 @@ func resourceSystemVneTunnelUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemVneTunnel resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_vnetunnel", obj)
+
 	o, err := c.UpdateSystemVneTunnel(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVneTunnel resource: %v", err)
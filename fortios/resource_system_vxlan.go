@@ -155,6 +155,8 @@ func resourceSystemVxlanUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemVxlan resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_vxlan", obj)
+
 	o, err := c.UpdateSystemVxlan(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVxlan resource: %v", err)
@@ -210,6 +210,8 @@ func resourceSystemWccpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemWccp resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_wccp", obj)
+
 	o, err := c.UpdateSystemWccp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemWccp resource: %v", err)
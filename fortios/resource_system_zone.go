@@ -160,6 +160,8 @@ func resourceSystemZoneUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemZone resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_system_zone", obj)
+
 	o, err := c.UpdateSystemZone(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemZone resource: %v", err)
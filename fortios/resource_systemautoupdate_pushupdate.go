@@ -74,6 +74,8 @@ func resourceSystemAutoupdatePushUpdateUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating SystemAutoupdatePushUpdate resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemautoupdate_pushupdate", obj)
+
 	o, err := c.UpdateSystemAutoupdatePushUpdate(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAutoupdatePushUpdate resource: %v", err)
@@ -74,6 +74,8 @@ func resourceSystemAutoupdateScheduleUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating SystemAutoupdateSchedule resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemautoupdate_schedule", obj)
+
 	o, err := c.UpdateSystemAutoupdateSchedule(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAutoupdateSchedule resource: %v", err)
@@ -86,6 +86,8 @@ func resourceSystemAutoupdateTunnelingUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating SystemAutoupdateTunneling resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemautoupdate_tunneling", obj)
+
 	o, err := c.UpdateSystemAutoupdateTunneling(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAutoupdateTunneling resource: %v", err)
@@ -248,6 +248,8 @@ func resourceSystemDhcp6ServerUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemDhcp6Server resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemdhcp6_server", obj)
+
 	o, err := c.UpdateSystemDhcp6Server(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemDhcp6Server resource: %v", err)
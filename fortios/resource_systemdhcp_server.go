@@ -549,6 +549,8 @@ func resourceSystemDhcpServerUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemDhcpServer resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemdhcp_server", obj)
+
 	o, err := c.UpdateSystemDhcpServer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemDhcpServer resource: %v", err)
@@ -404,6 +404,8 @@ func resourceSystemLldpNetworkPolicyUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemLldpNetworkPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemlldp_networkpolicy", obj)
+
 	o, err := c.UpdateSystemLldpNetworkPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemLldpNetworkPolicy resource: %v", err)
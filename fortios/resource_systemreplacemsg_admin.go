@@ -79,6 +79,8 @@ func resourceSystemReplacemsgAdminUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemReplacemsgAdmin resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemreplacemsg_admin", obj)
+
 	o, err := c.UpdateSystemReplacemsgAdmin(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgAdmin resource: %v", err)
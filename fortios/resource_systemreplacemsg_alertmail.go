@@ -79,6 +79,8 @@ func resourceSystemReplacemsgAlertmailUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating SystemReplacemsgAlertmail resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemreplacemsg_alertmail", obj)
+
 	o, err := c.UpdateSystemReplacemsgAlertmail(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgAlertmail resource: %v", err)
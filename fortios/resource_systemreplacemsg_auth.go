@@ -79,6 +79,8 @@ func resourceSystemReplacemsgAuthUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemReplacemsgAuth resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemreplacemsg_auth", obj)
+
 	o, err := c.UpdateSystemReplacemsgAuth(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgAuth resource: %v", err)
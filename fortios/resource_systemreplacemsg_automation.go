@@ -111,6 +111,8 @@ func resourceSystemReplacemsgAutomationUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating SystemReplacemsgAutomation resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemreplacemsg_automation", obj)
+
 	o, err := c.UpdateSystemReplacemsgAutomation(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgAutomation resource: %v", err)
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgDeviceDetectionPortalUpdate(d *schema.ResourceData,
 		return fmt.Errorf("Error updating SystemReplacemsgDeviceDetectionPortal resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemreplacemsg_devicedetectionportal", obj)
+
 	o, err := c.UpdateSystemReplacemsgDeviceDetectionPortal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgDeviceDetectionPortal resource: %v", err)
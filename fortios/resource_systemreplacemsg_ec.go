@@ -79,6 +79,8 @@ func resourceSystemReplacemsgEcUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating SystemReplacemsgEc resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemreplacemsg_ec", obj)
+
 	o, err := c.UpdateSystemReplacemsgEc(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgEc resource: %v", err)
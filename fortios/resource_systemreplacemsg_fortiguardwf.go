@@ -79,6 +79,8 @@ func resourceSystemReplacemsgFortiguardWfUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating SystemReplacemsgFortiguardWf resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemreplacemsg_fortiguardwf", obj)
+
 	o, err := c.UpdateSystemReplacemsgFortiguardWf(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgFortiguardWf resource: %v", err)
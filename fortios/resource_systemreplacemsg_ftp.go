@@ -79,6 +79,8 @@ func resourceSystemReplacemsgFtpUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemReplacemsgFtp resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemreplacemsg_ftp", obj)
+
 	o, err := c.UpdateSystemReplacemsgFtp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgFtp resource: %v", err)
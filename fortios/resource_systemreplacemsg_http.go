@@ -79,6 +79,8 @@ func resourceSystemReplacemsgHttpUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemReplacemsgHttp resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemreplacemsg_http", obj)
+
 	o, err := c.UpdateSystemReplacemsgHttp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgHttp resource: %v", err)
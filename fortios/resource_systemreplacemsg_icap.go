@@ -79,6 +79,8 @@ func resourceSystemReplacemsgIcapUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemReplacemsgIcap resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemreplacemsg_icap", obj)
+
 	o, err := c.UpdateSystemReplacemsgIcap(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgIcap resource: %v", err)
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgMailUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemReplacemsgMail resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemreplacemsg_mail", obj)
+
 	o, err := c.UpdateSystemReplacemsgMail(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgMail resource: %v", err)
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgNacQuarUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemReplacemsgNacQuar resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemreplacemsg_nacquar", obj)
+
 	o, err := c.UpdateSystemReplacemsgNacQuar(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgNacQuar resource: %v", err)
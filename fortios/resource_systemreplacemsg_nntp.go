@@ -79,6 +79,8 @@ func resourceSystemReplacemsgNntpUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemReplacemsgNntp resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemreplacemsg_nntp", obj)
+
 	o, err := c.UpdateSystemReplacemsgNntp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgNntp resource: %v", err)
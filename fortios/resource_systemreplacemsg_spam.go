@@ -79,6 +79,8 @@ func resourceSystemReplacemsgSpamUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemReplacemsgSpam resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemreplacemsg_spam", obj)
+
 	o, err := c.UpdateSystemReplacemsgSpam(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgSpam resource: %v", err)
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgSslvpnUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemReplacemsgSslvpn resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemreplacemsg_sslvpn", obj)
+
 	o, err := c.UpdateSystemReplacemsgSslvpn(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgSslvpn resource: %v", err)
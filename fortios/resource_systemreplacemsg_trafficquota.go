@@ -79,6 +79,8 @@ func resourceSystemReplacemsgTrafficQuotaUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating SystemReplacemsgTrafficQuota resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemreplacemsg_trafficquota", obj)
+
 	o, err := c.UpdateSystemReplacemsgTrafficQuota(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgTrafficQuota resource: %v", err)
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgUtmUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemReplacemsgUtm resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemreplacemsg_utm", obj)
+
 	o, err := c.UpdateSystemReplacemsgUtm(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgUtm resource: %v", err)
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgWebproxyUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating SystemReplacemsgWebproxy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemreplacemsg_webproxy", obj)
+
 	o, err := c.UpdateSystemReplacemsgWebproxy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgWebproxy resource: %v", err)
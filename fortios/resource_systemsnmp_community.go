@@ -256,6 +256,8 @@ func resourceSystemSnmpCommunityUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemSnmpCommunity resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemsnmp_community", obj)
+
 	o, err := c.UpdateSystemSnmpCommunity(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSnmpCommunity resource: %v", err)
@@ -108,6 +108,8 @@ func resourceSystemSnmpMibViewUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemSnmpMibView resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemsnmp_mibview", obj)
+
 	o, err := c.UpdateSystemSnmpMibView(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSnmpMibView resource: %v", err)
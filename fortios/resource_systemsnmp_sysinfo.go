@@ -106,6 +106,8 @@ func resourceSystemSnmpSysinfoUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemSnmpSysinfo resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemsnmp_sysinfo", obj)
+
 	o, err := c.UpdateSystemSnmpSysinfo(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSnmpSysinfo resource: %v", err)
@@ -210,6 +210,8 @@ func resourceSystemSnmpUserUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemSnmpUser resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_systemsnmp_user", obj)
+
 	o, err := c.UpdateSystemSnmpUser(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSnmpUser resource: %v", err)
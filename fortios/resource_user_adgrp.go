@@ -112,6 +112,8 @@ func resourceUserAdgrpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserAdgrp resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_adgrp", obj)
+
 	o, err := c.UpdateUserAdgrp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserAdgrp resource: %v", err)
@@ -123,6 +123,8 @@ func resourceUserCertificateUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating UserCertificate resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_certificate", obj)
+
 	o, err := c.UpdateUserCertificate(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserCertificate resource: %v", err)
@@ -172,6 +172,8 @@ func resourceUserDeviceUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserDevice resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_device", obj)
+
 	o, err := c.UpdateUserDevice(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserDevice resource: %v", err)
@@ -129,6 +129,8 @@ func resourceUserDeviceAccessListUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating UserDeviceAccessList resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_deviceaccesslist", obj)
+
 	o, err := c.UpdateUserDeviceAccessList(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserDeviceAccessList resource: %v", err)
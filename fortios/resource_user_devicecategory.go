@@ -105,6 +105,8 @@ func resourceUserDeviceCategoryUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating UserDeviceCategory resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_devicecategory", obj)
+
 	o, err := c.UpdateUserDeviceCategory(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserDeviceCategory resource: %v", err)
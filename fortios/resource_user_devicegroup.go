@@ -153,6 +153,8 @@ func resourceUserDeviceGroupUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating UserDeviceGroup resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_devicegroup", obj)
+
 	o, err := c.UpdateUserDeviceGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserDeviceGroup resource: %v", err)
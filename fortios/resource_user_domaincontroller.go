@@ -245,6 +245,8 @@ func resourceUserDomainControllerUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating UserDomainController resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_domaincontroller", obj)
+
 	o, err := c.UpdateUserDomainController(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserDomainController resource: %v", err)
@@ -174,6 +174,8 @@ func resourceUserExchangeUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserExchange resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_exchange", obj)
+
 	o, err := c.UpdateUserExchange(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserExchange resource: %v", err)
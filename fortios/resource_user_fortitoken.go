@@ -141,6 +141,8 @@ func resourceUserFortitokenUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserFortitoken resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_fortitoken", obj)
+
 	o, err := c.UpdateUserFortitoken(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserFortitoken resource: %v", err)
@@ -273,6 +273,8 @@ func resourceUserFssoUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserFsso resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_fsso", obj)
+
 	o, err := c.UpdateUserFsso(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserFsso resource: %v", err)
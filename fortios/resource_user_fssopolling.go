@@ -174,6 +174,8 @@ func resourceUserFssoPollingUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating UserFssoPolling resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_fssopolling", obj)
+
 	o, err := c.UpdateUserFssoPolling(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserFssoPolling resource: %v", err)
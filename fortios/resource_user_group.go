@@ -312,6 +312,8 @@ func resourceUserGroupUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserGroup resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_group", obj)
+
 	o, err := c.UpdateUserGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserGroup resource: %v", err)
@@ -117,6 +117,8 @@ func resourceUserKrbKeytabUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserKrbKeytab resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_krbkeytab", obj)
+
 	o, err := c.UpdateUserKrbKeytab(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserKrbKeytab resource: %v", err)
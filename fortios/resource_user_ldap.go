@@ -299,6 +299,8 @@ func resourceUserLdapUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserLdap resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_ldap", obj)
+
 	o, err := c.UpdateUserLdap(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserLdap resource: %v", err)
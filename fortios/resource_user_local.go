@@ -237,6 +237,8 @@ func resourceUserLocalUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserLocal resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_local", obj)
+
 	o, err := c.UpdateUserLocal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserLocal resource: %v", err)
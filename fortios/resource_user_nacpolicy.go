@@ -251,6 +251,8 @@ func resourceUserNacPolicyUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserNacPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_nacpolicy", obj)
+
 	o, err := c.UpdateUserNacPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserNacPolicy resource: %v", err)
@@ -113,6 +113,8 @@ func resourceUserPasswordPolicyUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating UserPasswordPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_passwordpolicy", obj)
+
 	o, err := c.UpdateUserPasswordPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserPasswordPolicy resource: %v", err)
@@ -164,6 +164,8 @@ func resourceUserPeerUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserPeer resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_peer", obj)
+
 	o, err := c.UpdateUserPeer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserPeer resource: %v", err)
@@ -115,6 +115,8 @@ func resourceUserPeergrpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserPeergrp resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_peergrp", obj)
+
 	o, err := c.UpdateUserPeergrp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserPeergrp resource: %v", err)
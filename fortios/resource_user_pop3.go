@@ -117,6 +117,8 @@ func resourceUserPop3Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserPop3 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_pop3", obj)
+
 	o, err := c.UpdateUserPop3(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserPop3 resource: %v", err)
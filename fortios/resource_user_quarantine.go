@@ -134,6 +134,8 @@ func resourceUserQuarantineUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserQuarantine resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_quarantine", obj)
+
 	o, err := c.UpdateUserQuarantine(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserQuarantine resource: %v", err)
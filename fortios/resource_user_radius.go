@@ -404,6 +404,8 @@ func resourceUserRadiusUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserRadius resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_radius", obj)
+
 	o, err := c.UpdateUserRadius(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserRadius resource: %v", err)
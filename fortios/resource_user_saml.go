@@ -187,6 +187,8 @@ func resourceUserSamlUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserSaml resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_saml", obj)
+
 	o, err := c.UpdateUserSaml(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserSaml resource: %v", err)
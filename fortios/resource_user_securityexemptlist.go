@@ -176,6 +176,8 @@ func resourceUserSecurityExemptListUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating UserSecurityExemptList resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_securityexemptlist", obj)
+
 	o, err := c.UpdateUserSecurityExemptList(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserSecurityExemptList resource: %v", err)
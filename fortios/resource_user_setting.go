@@ -193,6 +193,8 @@ func resourceUserSettingUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_setting", obj)
+
 	o, err := c.UpdateUserSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserSetting resource: %v", err)
@@ -165,6 +165,8 @@ func resourceUserTacacsUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserTacacs resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_user_tacacs", obj)
+
 	o, err := c.UpdateUserTacacs(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserTacacs resource: %v", err)
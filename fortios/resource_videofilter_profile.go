@@ -165,6 +165,8 @@ func resourceVideofilterProfileUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating VideofilterProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_videofilter_profile", obj)
+
 	o, err := c.UpdateVideofilterProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VideofilterProfile resource: %v", err)
@@ -155,6 +155,8 @@ func resourceVideofilterYoutubeChannelFilterUpdate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating VideofilterYoutubeChannelFilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_videofilter_youtubechannelfilter", obj)
+
 	o, err := c.UpdateVideofilterYoutubeChannelFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VideofilterYoutubeChannelFilter resource: %v", err)
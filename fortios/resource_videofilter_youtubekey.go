@@ -101,6 +101,8 @@ func resourceVideofilterYoutubeKeyUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating VideofilterYoutubeKey resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_videofilter_youtubekey", obj)
+
 	o, err := c.UpdateVideofilterYoutubeKey(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VideofilterYoutubeKey resource: %v", err)
@@ -759,6 +759,8 @@ func resourceVoipProfileUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VoipProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_voip_profile", obj)
+
 	o, err := c.UpdateVoipProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VoipProfile resource: %v", err)
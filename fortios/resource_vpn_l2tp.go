@@ -105,6 +105,8 @@ func resourceVpnL2TpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnL2Tp resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpn_l2tp", obj)
+
 	o, err := c.UpdateVpnL2Tp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnL2Tp resource: %v", err)
@@ -267,6 +267,8 @@ func resourceVpnOcvpnUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnOcvpn resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpn_ocvpn", obj)
+
 	o, err := c.UpdateVpnOcvpn(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnOcvpn resource: %v", err)
@@ -87,6 +87,8 @@ func resourceVpnPptpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnPptp resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpn_pptp", obj)
+
 	o, err := c.UpdateVpnPptp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnPptp resource: %v", err)
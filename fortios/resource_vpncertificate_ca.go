@@ -156,6 +156,8 @@ func resourceVpnCertificateCaUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating VpnCertificateCa resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpncertificate_ca", obj)
+
 	o, err := c.UpdateVpnCertificateCa(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnCertificateCa resource: %v", err)
@@ -167,6 +167,8 @@ func resourceVpnCertificateCrlUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating VpnCertificateCrl resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpncertificate_crl", obj)
+
 	o, err := c.UpdateVpnCertificateCrl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnCertificateCrl resource: %v", err)
@@ -256,6 +256,8 @@ func resourceVpnCertificateLocalUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating VpnCertificateLocal resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpncertificate_local", obj)
+
 	o, err := c.UpdateVpnCertificateLocal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnCertificateLocal resource: %v", err)
@@ -130,6 +130,8 @@ func resourceVpnCertificateOcspServerUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating VpnCertificateOcspServer resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpncertificate_ocspserver", obj)
+
 	o, err := c.UpdateVpnCertificateOcspServer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnCertificateOcspServer resource: %v", err)
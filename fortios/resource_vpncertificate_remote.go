@@ -110,6 +110,8 @@ func resourceVpnCertificateRemoteUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating VpnCertificateRemote resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpncertificate_remote", obj)
+
 	o, err := c.UpdateVpnCertificateRemote(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnCertificateRemote resource: %v", err)
@@ -229,6 +229,8 @@ func resourceVpnCertificateSettingUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating VpnCertificateSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpncertificate_setting", obj)
+
 	o, err := c.UpdateVpnCertificateSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnCertificateSetting resource: %v", err)
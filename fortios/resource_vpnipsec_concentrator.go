@@ -126,6 +126,8 @@ func resourceVpnIpsecConcentratorUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating VpnIpsecConcentrator resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpnipsec_concentrator", obj)
+
 	o, err := c.UpdateVpnIpsecConcentrator(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnIpsecConcentrator resource: %v", err)
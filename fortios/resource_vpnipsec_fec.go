@@ -153,6 +153,8 @@ func resourceVpnIpsecFecUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnIpsecFec resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpnipsec_fec", obj)
+
 	o, err := c.UpdateVpnIpsecFec(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnIpsecFec resource: %v", err)
@@ -111,6 +111,8 @@ func resourceVpnIpsecForticlientUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating VpnIpsecForticlient resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpnipsec_forticlient", obj)
+
 	o, err := c.UpdateVpnIpsecForticlient(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnIpsecForticlient resource: %v", err)
@@ -145,6 +145,8 @@ func resourceVpnIpsecManualkeyUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating VpnIpsecManualkey resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpnipsec_manualkey", obj)
+
 	o, err := c.UpdateVpnIpsecManualkey(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnIpsecManualkey resource: %v", err)
@@ -164,6 +164,8 @@ func resourceVpnIpsecManualkeyInterfaceUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating VpnIpsecManualkeyInterface resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpnipsec_manualkeyinterface", obj)
+
 	o, err := c.UpdateVpnIpsecManualkeyInterface(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnIpsecManualkeyInterface resource: %v", err)
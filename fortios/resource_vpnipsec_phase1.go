@@ -794,6 +794,8 @@ func resourceVpnIpsecPhase1Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnIpsecPhase1 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpnipsec_phase1", obj)
+
 	o, err := c.UpdateVpnIpsecPhase1(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnIpsecPhase1 resource: %v", err)
@@ -970,6 +970,8 @@ func resourceVpnIpsecPhase1InterfaceUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating VpnIpsecPhase1Interface resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpnipsec_phase1interface", obj)
+
 	o, err := c.UpdateVpnIpsecPhase1Interface(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnIpsecPhase1Interface resource: %v", err)
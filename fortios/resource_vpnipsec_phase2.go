@@ -327,6 +327,8 @@ func resourceVpnIpsecPhase2Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnIpsecPhase2 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpnipsec_phase2", obj)
+
 	o, err := c.UpdateVpnIpsecPhase2(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnIpsecPhase2 resource: %v", err)
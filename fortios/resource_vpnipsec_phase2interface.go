@@ -327,6 +327,8 @@ func resourceVpnIpsecPhase2InterfaceUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating VpnIpsecPhase2Interface resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpnipsec_phase2interface", obj)
+
 	o, err := c.UpdateVpnIpsecPhase2Interface(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnIpsecPhase2Interface resource: %v", err)
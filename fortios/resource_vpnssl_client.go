@@ -174,6 +174,8 @@ func resourceVpnSslClientUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnSslClient resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpnssl_client", obj)
+
 	o, err := c.UpdateVpnSslClient(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnSslClient resource: %v", err)
@@ -605,6 +605,8 @@ func resourceVpnSslSettingsUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnSslSettings resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpnssl_settings", obj)
+
 	o, err := c.UpdateVpnSslSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnSslSettings resource: %v", err)
@@ -172,6 +172,8 @@ func resourceVpnSslWebHostCheckSoftwareUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating VpnSslWebHostCheckSoftware resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpnsslweb_hostchecksoftware", obj)
+
 	o, err := c.UpdateVpnSslWebHostCheckSoftware(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnSslWebHostCheckSoftware resource: %v", err)
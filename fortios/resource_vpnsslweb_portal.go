@@ -781,6 +781,8 @@ func resourceVpnSslWebPortalUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating VpnSslWebPortal resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpnsslweb_portal", obj)
+
 	o, err := c.UpdateVpnSslWebPortal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnSslWebPortal resource: %v", err)
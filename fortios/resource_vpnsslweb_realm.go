@@ -140,6 +140,8 @@ func resourceVpnSslWebRealmUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnSslWebRealm resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpnsslweb_realm", obj)
+
 	o, err := c.UpdateVpnSslWebRealm(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnSslWebRealm resource: %v", err)
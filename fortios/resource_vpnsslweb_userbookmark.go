@@ -292,6 +292,8 @@ func resourceVpnSslWebUserBookmarkUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating VpnSslWebUserBookmark resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpnsslweb_userbookmark", obj)
+
 	o, err := c.UpdateVpnSslWebUserBookmark(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnSslWebUserBookmark resource: %v", err)
@@ -286,6 +286,8 @@ func resourceVpnSslWebUserGroupBookmarkUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating VpnSslWebUserGroupBookmark resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_vpnsslweb_usergroupbookmark", obj)
+
 	o, err := c.UpdateVpnSslWebUserGroupBookmark(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnSslWebUserGroupBookmark resource: %v", err)
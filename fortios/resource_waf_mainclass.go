@@ -101,6 +101,8 @@ func resourceWafMainClassUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WafMainClass resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_waf_mainclass", obj)
+
 	o, err := c.UpdateWafMainClass(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WafMainClass resource: %v", err)
@@ -973,6 +973,8 @@ func resourceWafProfileUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WafProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_waf_profile", obj)
+
 	o, err := c.UpdateWafProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WafProfile resource: %v", err)
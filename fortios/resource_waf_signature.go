@@ -101,6 +101,8 @@ func resourceWafSignatureUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WafSignature resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_waf_signature", obj)
+
 	o, err := c.UpdateWafSignature(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WafSignature resource: %v", err)
@@ -101,6 +101,8 @@ func resourceWafSubClassUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WafSubClass resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_waf_subclass", obj)
+
 	o, err := c.UpdateWafSubClass(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WafSubClass resource: %v", err)
@@ -123,6 +123,8 @@ func resourceWanoptAuthGroupUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating WanoptAuthGroup resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wanopt_authgroup", obj)
+
 	o, err := c.UpdateWanoptAuthGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WanoptAuthGroup resource: %v", err)
@@ -157,6 +157,8 @@ func resourceWanoptCacheServiceUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating WanoptCacheService resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wanopt_cacheservice", obj)
+
 	o, err := c.UpdateWanoptCacheService(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WanoptCacheService resource: %v", err)
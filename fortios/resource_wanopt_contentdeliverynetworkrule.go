@@ -296,6 +296,8 @@ func resourceWanoptContentDeliveryNetworkRuleUpdate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error updating WanoptContentDeliveryNetworkRule resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wanopt_contentdeliverynetworkrule", obj)
+
 	o, err := c.UpdateWanoptContentDeliveryNetworkRule(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WanoptContentDeliveryNetworkRule resource: %v", err)
@@ -101,6 +101,8 @@ func resourceWanoptPeerUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WanoptPeer resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wanopt_peer", obj)
+
 	o, err := c.UpdateWanoptPeer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WanoptPeer resource: %v", err)
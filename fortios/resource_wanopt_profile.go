@@ -388,6 +388,8 @@ func resourceWanoptProfileUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WanoptProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wanopt_profile", obj)
+
 	o, err := c.UpdateWanoptProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WanoptProfile resource: %v", err)
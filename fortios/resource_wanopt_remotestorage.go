@@ -79,6 +79,8 @@ func resourceWanoptRemoteStorageUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating WanoptRemoteStorage resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wanopt_remotestorage", obj)
+
 	o, err := c.UpdateWanoptRemoteStorage(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WanoptRemoteStorage resource: %v", err)
@@ -77,6 +77,8 @@ func resourceWanoptSettingsUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WanoptSettings resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wanopt_settings", obj)
+
 	o, err := c.UpdateWanoptSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WanoptSettings resource: %v", err)
@@ -147,6 +147,8 @@ func resourceWanoptWebcacheUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WanoptWebcache resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wanopt_webcache", obj)
+
 	o, err := c.UpdateWanoptWebcache(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WanoptWebcache resource: %v", err)
@@ -148,6 +148,8 @@ func resourceWebfilterContentUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating WebfilterContent resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_webfilter_content", obj)
+
 	o, err := c.UpdateWebfilterContent(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterContent resource: %v", err)
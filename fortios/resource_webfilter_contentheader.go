@@ -133,6 +133,8 @@ func resourceWebfilterContentHeaderUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating WebfilterContentHeader resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_webfilter_contentheader", obj)
+
 	o, err := c.UpdateWebfilterContentHeader(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterContentHeader resource: %v", err)
@@ -124,6 +124,8 @@ func resourceWebfilterFortiguardUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating WebfilterFortiguard resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_webfilter_fortiguard", obj)
+
 	o, err := c.UpdateWebfilterFortiguard(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterFortiguard resource: %v", err)
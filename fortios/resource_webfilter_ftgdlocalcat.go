@@ -107,6 +107,8 @@ func resourceWebfilterFtgdLocalCatUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating WebfilterFtgdLocalCat resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_webfilter_ftgdlocalcat", obj)
+
 	o, err := c.UpdateWebfilterFtgdLocalCat(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterFtgdLocalCat resource: %v", err)
@@ -110,6 +110,8 @@ func resourceWebfilterFtgdLocalRatingUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating WebfilterFtgdLocalRating resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_webfilter_ftgdlocalrating", obj)
+
 	o, err := c.UpdateWebfilterFtgdLocalRating(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterFtgdLocalRating resource: %v", err)
@@ -79,6 +79,8 @@ func resourceWebfilterIpsUrlfilterSettingUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating WebfilterIpsUrlfilterSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_webfilter_ipsurlfiltersetting", obj)
+
 	o, err := c.UpdateWebfilterIpsUrlfilterSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterIpsUrlfilterSetting resource: %v", err)
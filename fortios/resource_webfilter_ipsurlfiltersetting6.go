@@ -79,6 +79,8 @@ func resourceWebfilterIpsUrlfilterSetting6Update(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating WebfilterIpsUrlfilterSetting6 resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_webfilter_ipsurlfiltersetting6", obj)
+
 	o, err := c.UpdateWebfilterIpsUrlfilterSetting6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterIpsUrlfilterSetting6 resource: %v", err)
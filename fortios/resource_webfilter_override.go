@@ -146,6 +146,8 @@ func resourceWebfilterOverrideUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating WebfilterOverride resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_webfilter_override", obj)
+
 	o, err := c.UpdateWebfilterOverride(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterOverride resource: %v", err)
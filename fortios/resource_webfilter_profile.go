@@ -785,6 +785,8 @@ func resourceWebfilterProfileUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating WebfilterProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_webfilter_profile", obj)
+
 	o, err := c.UpdateWebfilterProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterProfile resource: %v", err)
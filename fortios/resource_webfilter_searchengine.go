@@ -129,6 +129,8 @@ func resourceWebfilterSearchEngineUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating WebfilterSearchEngine resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_webfilter_searchengine", obj)
+
 	o, err := c.UpdateWebfilterSearchEngine(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterSearchEngine resource: %v", err)
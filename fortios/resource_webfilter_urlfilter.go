@@ -180,6 +180,8 @@ func resourceWebfilterUrlfilterUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating WebfilterUrlfilter resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_webfilter_urlfilter", obj)
+
 	o, err := c.UpdateWebfilterUrlfilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterUrlfilter resource: %v", err)
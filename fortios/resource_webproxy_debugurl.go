@@ -111,6 +111,8 @@ func resourceWebProxyDebugUrlUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating WebProxyDebugUrl resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_webproxy_debugurl", obj)
+
 	o, err := c.UpdateWebProxyDebugUrl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebProxyDebugUrl resource: %v", err)
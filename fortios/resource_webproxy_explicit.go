@@ -276,6 +276,8 @@ func resourceWebProxyExplicitUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating WebProxyExplicit resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_webproxy_explicit", obj)
+
 	o, err := c.UpdateWebProxyExplicit(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebProxyExplicit resource: %v", err)
@@ -152,6 +152,8 @@ func resourceWebProxyForwardServerUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating WebProxyForwardServer resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_webproxy_forwardserver", obj)
+
 	o, err := c.UpdateWebProxyForwardServer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebProxyForwardServer resource: %v", err)
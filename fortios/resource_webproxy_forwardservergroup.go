@@ -136,6 +136,8 @@ func resourceWebProxyForwardServerGroupUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating WebProxyForwardServerGroup resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_webproxy_forwardservergroup", obj)
+
 	o, err := c.UpdateWebProxyForwardServerGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebProxyForwardServerGroup resource: %v", err)
@@ -187,6 +187,8 @@ func resourceWebProxyGlobalUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WebProxyGlobal resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_webproxy_global", obj)
+
 	o, err := c.UpdateWebProxyGlobal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebProxyGlobal resource: %v", err)
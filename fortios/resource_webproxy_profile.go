@@ -224,6 +224,8 @@ func resourceWebProxyProfileUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating WebProxyProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_webproxy_profile", obj)
+
 	o, err := c.UpdateWebProxyProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebProxyProfile resource: %v", err)
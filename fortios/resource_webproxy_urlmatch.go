@@ -122,6 +122,8 @@ func resourceWebProxyUrlMatchUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating WebProxyUrlMatch resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_webproxy_urlmatch", obj)
+
 	o, err := c.UpdateWebProxyUrlMatch(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebProxyUrlMatch resource: %v", err)
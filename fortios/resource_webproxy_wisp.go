@@ -126,6 +126,8 @@ func resourceWebProxyWispUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WebProxyWisp resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_webproxy_wisp", obj)
+
 	o, err := c.UpdateWebProxyWisp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebProxyWisp resource: %v", err)
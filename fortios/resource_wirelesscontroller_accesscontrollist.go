@@ -213,6 +213,8 @@ func resourceWirelessControllerAccessControlListUpdate(d *schema.ResourceData, m
 		return fmt.Errorf("Error updating WirelessControllerAccessControlList resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_accesscontrollist", obj)
+
 	o, err := c.UpdateWirelessControllerAccessControlList(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerAccessControlList resource: %v", err)
@@ -106,6 +106,8 @@ func resourceWirelessControllerAddressUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating WirelessControllerAddress resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_address", obj)
+
 	o, err := c.UpdateWirelessControllerAddress(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerAddress resource: %v", err)
@@ -120,6 +120,8 @@ func resourceWirelessControllerAddrgrpUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating WirelessControllerAddrgrp resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_addrgrp", obj)
+
 	o, err := c.UpdateWirelessControllerAddrgrp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerAddrgrp resource: %v", err)
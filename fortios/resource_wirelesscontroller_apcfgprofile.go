@@ -170,6 +170,8 @@ func resourceWirelessControllerApcfgProfileUpdate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error updating WirelessControllerApcfgProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_apcfgprofile", obj)
+
 	o, err := c.UpdateWirelessControllerApcfgProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerApcfgProfile resource: %v", err)
@@ -111,6 +111,8 @@ func resourceWirelessControllerApStatusUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating WirelessControllerApStatus resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_apstatus", obj)
+
 	o, err := c.UpdateWirelessControllerApStatus(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerApStatus resource: %v", err)
@@ -231,6 +231,8 @@ func resourceWirelessControllerArrpProfileUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating WirelessControllerArrpProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_arrpprofile", obj)
+
 	o, err := c.UpdateWirelessControllerArrpProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerArrpProfile resource: %v", err)
@@ -165,6 +165,8 @@ func resourceWirelessControllerBleProfileUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating WirelessControllerBleProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_bleprofile", obj)
+
 	o, err := c.UpdateWirelessControllerBleProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerBleProfile resource: %v", err)
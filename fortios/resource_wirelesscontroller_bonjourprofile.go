@@ -144,6 +144,8 @@ func resourceWirelessControllerBonjourProfileUpdate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error updating WirelessControllerBonjourProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_bonjourprofile", obj)
+
 	o, err := c.UpdateWirelessControllerBonjourProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerBonjourProfile resource: %v", err)
@@ -160,6 +160,8 @@ func resourceWirelessControllerGlobalUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating WirelessControllerGlobal resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_global", obj)
+
 	o, err := c.UpdateWirelessControllerGlobal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerGlobal resource: %v", err)
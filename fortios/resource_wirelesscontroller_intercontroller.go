@@ -124,6 +124,8 @@ func resourceWirelessControllerInterControllerUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating WirelessControllerInterController resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_intercontroller", obj)
+
 	o, err := c.UpdateWirelessControllerInterController(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerInterController resource: %v", err)
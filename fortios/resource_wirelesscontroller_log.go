@@ -117,6 +117,8 @@ func resourceWirelessControllerLogUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating WirelessControllerLog resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_log", obj)
+
 	o, err := c.UpdateWirelessControllerLog(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerLog resource: %v", err)
@@ -187,6 +187,8 @@ func resourceWirelessControllerMpskProfileUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating WirelessControllerMpskProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_mpskprofile", obj)
+
 	o, err := c.UpdateWirelessControllerMpskProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerMpskProfile resource: %v", err)
@@ -107,6 +107,8 @@ func resourceWirelessControllerNacProfileUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating WirelessControllerNacProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_nacprofile", obj)
+
 	o, err := c.UpdateWirelessControllerNacProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerNacProfile resource: %v", err)
@@ -258,6 +258,8 @@ func resourceWirelessControllerQosProfileUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating WirelessControllerQosProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_qosprofile", obj)
+
 	o, err := c.UpdateWirelessControllerQosProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerQosProfile resource: %v", err)
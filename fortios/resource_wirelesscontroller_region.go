@@ -118,6 +118,8 @@ func resourceWirelessControllerRegionUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating WirelessControllerRegion resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_region", obj)
+
 	o, err := c.UpdateWirelessControllerRegion(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerRegion resource: %v", err)
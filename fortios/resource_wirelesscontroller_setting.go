@@ -166,6 +166,8 @@ func resourceWirelessControllerSettingUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating WirelessControllerSetting resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_setting", obj)
+
 	o, err := c.UpdateWirelessControllerSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerSetting resource: %v", err)
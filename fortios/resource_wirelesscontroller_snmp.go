@@ -209,6 +209,8 @@ func resourceWirelessControllerSnmpUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating WirelessControllerSnmp resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_snmp", obj)
+
 	o, err := c.UpdateWirelessControllerSnmp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerSnmp resource: %v", err)
@@ -107,6 +107,8 @@ func resourceWirelessControllerSsidPolicyUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating WirelessControllerSsidPolicy resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_ssidpolicy", obj)
+
 	o, err := c.UpdateWirelessControllerSsidPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerSsidPolicy resource: %v", err)
@@ -133,6 +133,8 @@ func resourceWirelessControllerSyslogProfileUpdate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating WirelessControllerSyslogProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_syslogprofile", obj)
+
 	o, err := c.UpdateWirelessControllerSyslogProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerSyslogProfile resource: %v", err)
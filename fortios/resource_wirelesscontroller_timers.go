@@ -182,6 +182,8 @@ func resourceWirelessControllerTimersUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating WirelessControllerTimers resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_timers", obj)
+
 	o, err := c.UpdateWirelessControllerTimers(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerTimers resource: %v", err)
@@ -136,6 +136,8 @@ func resourceWirelessControllerUtmProfileUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating WirelessControllerUtmProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_utmprofile", obj)
+
 	o, err := c.UpdateWirelessControllerUtmProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerUtmProfile resource: %v", err)
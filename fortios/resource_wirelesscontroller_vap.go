@@ -1173,6 +1173,8 @@ func resourceWirelessControllerVapUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating WirelessControllerVap resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_vap", obj)
+
 	o, err := c.UpdateWirelessControllerVap(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerVap resource: %v", err)
@@ -119,6 +119,8 @@ func resourceWirelessControllerVapGroupUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating WirelessControllerVapGroup resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_vapgroup", obj)
+
 	o, err := c.UpdateWirelessControllerVapGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerVapGroup resource: %v", err)
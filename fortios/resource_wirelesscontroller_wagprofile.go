@@ -140,6 +140,8 @@ func resourceWirelessControllerWagProfileUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating WirelessControllerWagProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_wagprofile", obj)
+
 	o, err := c.UpdateWirelessControllerWagProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerWagProfile resource: %v", err)
@@ -386,6 +386,8 @@ func resourceWirelessControllerWidsProfileUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating WirelessControllerWidsProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_widsprofile", obj)
+
 	o, err := c.UpdateWirelessControllerWidsProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerWidsProfile resource: %v", err)
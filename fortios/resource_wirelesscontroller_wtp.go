@@ -923,6 +923,8 @@ func resourceWirelessControllerWtpUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating WirelessControllerWtp resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_wtp", obj)
+
 	o, err := c.UpdateWirelessControllerWtp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerWtp resource: %v", err)
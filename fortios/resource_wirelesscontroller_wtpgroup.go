@@ -120,6 +120,8 @@ func resourceWirelessControllerWtpGroupUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating WirelessControllerWtpGroup resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_wtpgroup", obj)
+
 	o, err := c.UpdateWirelessControllerWtpGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerWtpGroup resource: %v", err)
@@ -2319,6 +2319,8 @@ func resourceWirelessControllerWtpProfileUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating WirelessControllerWtpProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontroller_wtpprofile", obj)
+
 	o, err := c.UpdateWirelessControllerWtpProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerWtpProfile resource: %v", err)
@@ -127,6 +127,8 @@ func resourceWirelessControllerHotspot20Anqp3GppCellularUpdate(d *schema.Resourc
 		return fmt.Errorf("Error updating WirelessControllerHotspot20Anqp3GppCellular resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontrollerhotspot20_anqp3gppcellular", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20Anqp3GppCellular(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20Anqp3GppCellular resource: %v", err)
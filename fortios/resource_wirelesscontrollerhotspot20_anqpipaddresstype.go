@@ -106,6 +106,8 @@ func resourceWirelessControllerHotspot20AnqpIpAddressTypeUpdate(d *schema.Resour
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpIpAddressType resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontrollerhotspot20_anqpipaddresstype", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20AnqpIpAddressType(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpIpAddressType resource: %v", err)
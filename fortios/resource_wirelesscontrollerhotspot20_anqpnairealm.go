@@ -169,6 +169,8 @@ func resourceWirelessControllerHotspot20AnqpNaiRealmUpdate(d *schema.ResourceDat
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpNaiRealm resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontrollerhotspot20_anqpnairealm", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20AnqpNaiRealm(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpNaiRealm resource: %v", err)
@@ -107,6 +107,8 @@ func resourceWirelessControllerHotspot20AnqpNetworkAuthTypeUpdate(d *schema.Reso
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpNetworkAuthType resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontrollerhotspot20_anqpnetworkauthtype", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20AnqpNetworkAuthType(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpNetworkAuthType resource: %v", err)
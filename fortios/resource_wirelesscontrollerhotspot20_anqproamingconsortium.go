@@ -127,6 +127,8 @@ func resourceWirelessControllerHotspot20AnqpRoamingConsortiumUpdate(d *schema.Re
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpRoamingConsortium resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontrollerhotspot20_anqproamingconsortium", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20AnqpRoamingConsortium(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpRoamingConsortium resource: %v", err)
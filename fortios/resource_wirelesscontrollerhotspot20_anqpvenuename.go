@@ -127,6 +127,8 @@ func resourceWirelessControllerHotspot20AnqpVenueNameUpdate(d *schema.ResourceDa
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpVenueName resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontrollerhotspot20_anqpvenuename", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20AnqpVenueName(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpVenueName resource: %v", err)
@@ -127,6 +127,8 @@ func resourceWirelessControllerHotspot20AnqpVenueUrlUpdate(d *schema.ResourceDat
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpVenueUrl resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontrollerhotspot20_anqpvenueurl", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20AnqpVenueUrl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpVenueUrl resource: %v", err)
@@ -164,6 +164,8 @@ func resourceWirelessControllerHotspot20H2QpAdviceOfChargeUpdate(d *schema.Resou
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpAdviceOfCharge resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontrollerhotspot20_h2qpadviceofcharge", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20H2QpAdviceOfCharge(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpAdviceOfCharge resource: %v", err)
@@ -151,6 +151,8 @@ func resourceWirelessControllerHotspot20H2QpConnCapabilityUpdate(d *schema.Resou
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpConnCapability resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontrollerhotspot20_h2qpconncapability", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20H2QpConnCapability(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpConnCapability resource: %v", err)
@@ -127,6 +127,8 @@ func resourceWirelessControllerHotspot20H2QpOperatorNameUpdate(d *schema.Resourc
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpOperatorName resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontrollerhotspot20_h2qpoperatorname", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20H2QpOperatorName(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpOperatorName resource: %v", err)
@@ -175,6 +175,8 @@ func resourceWirelessControllerHotspot20H2QpOsuProviderUpdate(d *schema.Resource
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpOsuProvider resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontrollerhotspot20_h2qposuprovider", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20H2QpOsuProvider(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpOsuProvider resource: %v", err)
@@ -121,6 +121,8 @@ func resourceWirelessControllerHotspot20H2QpOsuProviderNaiUpdate(d *schema.Resou
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpOsuProviderNai resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontrollerhotspot20_h2qposuprovidernai", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20H2QpOsuProviderNai(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpOsuProviderNai resource: %v", err)
@@ -113,6 +113,8 @@ func resourceWirelessControllerHotspot20H2QpTermsAndConditionsUpdate(d *schema.R
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpTermsAndConditions resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontrollerhotspot20_h2qptermsandconditions", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20H2QpTermsAndConditions(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpTermsAndConditions resource: %v", err)
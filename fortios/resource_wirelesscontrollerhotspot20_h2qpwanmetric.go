@@ -139,6 +139,8 @@ func resourceWirelessControllerHotspot20H2QpWanMetricUpdate(d *schema.ResourceDa
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpWanMetric resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontrollerhotspot20_h2qpwanmetric", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20H2QpWanMetric(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpWanMetric resource: %v", err)
@@ -317,6 +317,8 @@ func resourceWirelessControllerHotspot20HsProfileUpdate(d *schema.ResourceData,
 		return fmt.Errorf("Error updating WirelessControllerHotspot20HsProfile resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontrollerhotspot20_hsprofile", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20HsProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20HsProfile resource: %v", err)
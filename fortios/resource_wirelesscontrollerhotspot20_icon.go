@@ -144,6 +144,8 @@ func resourceWirelessControllerHotspot20IconUpdate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating WirelessControllerHotspot20Icon resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontrollerhotspot20_icon", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20Icon(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20Icon resource: %v", err)
@@ -159,6 +159,8 @@ func resourceWirelessControllerHotspot20QosMapUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating WirelessControllerHotspot20QosMap resource while getting object: %v", err)
 	}
 
+	cmdbUpdateObject(d, m, "fortios_wirelesscontrollerhotspot20_qosmap", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20QosMap(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20QosMap resource: %v", err)
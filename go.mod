@@ -5,6 +5,8 @@ go 1.13
 require (
 	github.com/fortinetdev/forti-sdk-go v1.9.2
 	github.com/google/uuid v1.1.2
+	github.com/hashicorp/go-cty v1.4.1-0.20200414143053-d3edf31b6320
 	github.com/hashicorp/go-version v1.6.0
+	github.com/hashicorp/terraform-plugin-go v0.14.0
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.23.0
 )
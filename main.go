@@ -7,5 +7,7 @@ import (
 
 func main() {
 	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: fortios.Provider})
+		GRPCProviderFunc: fortios.ProviderServer})
+
+	fortios.CloseClients()
 }
@@ -0,0 +1,380 @@
+// Command cmdbversions generates fortios/cmdb_versions_gen.go, the FortiOS
+// versions supporting each CMDB table and attribute of the generated
+// resources.
+//
+// The versions are computed from the CMDB schemas of FortiGates running
+// different FortiOS versions, kept under the schemas directory as
+// <version>/<table path>.json. The schemas of a FortiGate are added with:
+//
+//	make cmdbversions FETCH="-fetch https://<FortiGate> -token <API key>"
+//
+// which saves the schema of every table listed in fortios/cmdb_resources.go
+// under the version of the device and regenerates the file. The schemas of
+// one FortiGate per supported FortiOS release are needed for the ranges to
+// be complete.
+package main
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+)
+
+type attrSchema struct {
+	Children map[string]*attrSchema `json:"children"`
+}
+
+type versionRange struct {
+	since   string
+	removed string
+}
+
+func main() {
+	resources := flag.String("resources", "fortios/cmdb_resources.go", "file listing the CMDB tables of the resources")
+	schemas := flag.String("schemas", "scripts/cmdbversions/schemas", "directory of the CMDB schemas per FortiOS version")
+	out := flag.String("o", "fortios/cmdb_versions_gen.go", "generated file")
+	fetch := flag.String("fetch", "", "URL of a FortiGate to read the CMDB schemas from before generating")
+	tok := flag.String("token", os.Getenv("FORTIOS_ACCESS_TOKEN"), "API key of the FortiGate given with -fetch")
+	insecure := flag.Bool("insecure", false, "skip the verification of the certificate of the FortiGate given with -fetch")
+	flag.Parse()
+
+	paths, err := readPaths(*resources)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *fetch != "" {
+		if err := fetchSchemas(*fetch, *tok, *insecure, paths, *schemas); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	versions, err := readVersions(*schemas)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	tables := make(map[string]versionRange)
+	attrs := make(map[string]map[string]versionRange)
+
+	for _, path := range paths {
+		present := make([]bool, len(versions))
+		attrPresent := make(map[string][]bool)
+
+		for i, v := range versions {
+			b, err := ioutil.ReadFile(filepath.Join(*schemas, v.Original(), path+".json"))
+			if os.IsNotExist(err) {
+				continue
+			}
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			s := &attrSchema{}
+			if err := json.Unmarshal(b, s); err != nil {
+				log.Fatalf("cannot decode the schema of %s for FortiOS %s: %v", path, v.Original(), err)
+			}
+
+			present[i] = true
+			for _, a := range flatten(s.Children, "") {
+				if attrPresent[a] == nil {
+					attrPresent[a] = make([]bool, len(versions))
+				}
+				attrPresent[a][i] = true
+			}
+		}
+
+		vr, ok := computeRange(versions, present)
+		if !ok {
+			continue
+		}
+		if vr != (versionRange{}) {
+			tables[path] = vr
+		}
+
+		// the versions without the table don't tell about its attributes
+		var tableVersions []*version.Version
+		for i, v := range versions {
+			if present[i] {
+				tableVersions = append(tableVersions, v)
+			}
+		}
+
+		for a, p := range attrPresent {
+			var ap []bool
+			for i := range versions {
+				if present[i] {
+					ap = append(ap, p[i])
+				}
+			}
+			if ar, ok := computeRange(tableVersions, ap); ok && ar != (versionRange{}) {
+				if attrs[path] == nil {
+					attrs[path] = make(map[string]versionRange)
+				}
+				attrs[path][a] = ar
+			}
+		}
+	}
+
+	src, err := generate(versions, tables, attrs)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readPaths returns the table paths of the cmdbResources map declared in file
+func readPaths(file string) ([]string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), file, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	ast.Inspect(f, func(n ast.Node) bool {
+		vs, ok := n.(*ast.ValueSpec)
+		if !ok || len(vs.Names) != 1 || vs.Names[0].Name != "cmdbResources" || len(vs.Values) != 1 {
+			return true
+		}
+
+		cl, ok := vs.Values[0].(*ast.CompositeLit)
+		if !ok {
+			return false
+		}
+		for _, e := range cl.Elts {
+			kv, ok := e.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			v, ok := kv.Value.(*ast.CompositeLit)
+			if !ok || len(v.Elts) == 0 {
+				continue
+			}
+			if lit, ok := v.Elts[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				if p, err := strconv.Unquote(lit.Value); err == nil {
+					paths = append(paths, p)
+				}
+			}
+		}
+		return false
+	})
+
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no CMDB table found in %s", file)
+	}
+	sort.Strings(paths)
+
+	return paths, nil
+}
+
+// fetchSchemas saves the schemas of the tables at paths served by the
+// FortiGate at url under the directory of its FortiOS version in dir
+func fetchSchemas(url, tok string, insecure bool, paths []string, dir string) error {
+	c := &http.Client{
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: insecure},
+		},
+	}
+
+	for _, path := range paths {
+		req, err := http.NewRequest("GET", strings.TrimSuffix(url, "/")+"/api/v2/cmdb/"+path+"?action=schema", nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+tok)
+
+		resp, err := c.Do(req)
+		if err != nil {
+			return err
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			continue
+		}
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("cannot read the schema of %s: %s", path, resp.Status)
+		}
+
+		var result struct {
+			Version string          `json:"version"`
+			Results json.RawMessage `json:"results"`
+		}
+		if err := json.Unmarshal(body, &result); err != nil {
+			return fmt.Errorf("cannot decode the schema of %s: %v", path, err)
+		}
+		if result.Version == "" || len(result.Results) == 0 {
+			continue
+		}
+
+		var b bytes.Buffer
+		if err := json.Indent(&b, result.Results, "", "  "); err != nil {
+			return err
+		}
+		b.WriteByte('\n')
+
+		file := filepath.Join(dir, strings.TrimPrefix(result.Version, "v"), path+".json")
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(file, b.Bytes(), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readVersions returns the FortiOS versions having schemas in dir, sorted
+func readVersions(dir string) ([]*version.Version, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	var versions []*version.Version
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		v, err := version.NewVersion(e.Name())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", filepath.Join(dir, e.Name()), err)
+		}
+		versions = append(versions, v)
+	}
+	sort.Sort(version.Collection(versions))
+
+	return versions, nil
+}
+
+// flatten returns the attributes of children and of their sub-tables, as
+// sub-table.attribute for the latter
+func flatten(children map[string]*attrSchema, pre string) []string {
+	var l []string
+
+	for k, a := range children {
+		l = append(l, pre+k)
+		if a != nil && len(a.Children) != 0 {
+			l = append(l, flatten(a.Children, pre+k+".")...)
+		}
+	}
+
+	return l
+}
+
+// computeRange returns the range of versions in which present is true, from
+// the first of them to the first version without it after the last of them,
+// false is returned if none has it
+func computeRange(versions []*version.Version, present []bool) (versionRange, bool) {
+	first, last := -1, -1
+	for i, p := range present {
+		if p {
+			if first < 0 {
+				first = i
+			}
+			last = i
+		}
+	}
+	if first < 0 {
+		return versionRange{}, false
+	}
+
+	var vr versionRange
+	if first > 0 {
+		vr.since = versions[first].Original()
+	}
+	if last < len(versions)-1 {
+		vr.removed = versions[last+1].Original()
+	}
+
+	return vr, true
+}
+
+func generate(versions []*version.Version, tables map[string]versionRange, attrs map[string]map[string]versionRange) ([]byte, error) {
+	var b bytes.Buffer
+
+	vs := make([]string, 0, len(versions))
+	for _, v := range versions {
+		vs = append(vs, v.Original())
+	}
+
+	fmt.Fprintf(&b, "// Code generated by scripts/cmdbversions; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package fortios\n\n")
+	if len(vs) != 0 {
+		fmt.Fprintf(&b, "// Computed from the CMDB schemas of FortiOS %s.\n\n", strings.Join(vs, ", "))
+	} else {
+		fmt.Fprintf(&b, "// No CMDB schema was found under scripts/cmdbversions/schemas, only the\n// versions listed in cmdb_versions.go are checked.\n\n")
+	}
+
+	fmt.Fprintf(&b, "// cmdbTableVersions lists the CMDB tables only served by some FortiOS\n// versions, indexed by path\n")
+	fmt.Fprintf(&b, "var cmdbTableVersions = map[string]cmdbVersionRange{\n")
+	for _, p := range sortedKeys(tables) {
+		fmt.Fprintf(&b, "%q: %s,\n", p, rangeLiteral(tables[p]))
+	}
+	fmt.Fprintf(&b, "}\n\n")
+
+	fmt.Fprintf(&b, "// cmdbTableAttrVersions lists the attributes only supported by some FortiOS\n// versions, indexed by table path then by attribute, the attributes of\n// sub-tables are written as sub-table.attribute\n")
+	fmt.Fprintf(&b, "var cmdbTableAttrVersions = map[string]map[string]cmdbVersionRange{\n")
+	paths := make([]string, 0, len(attrs))
+	for p := range attrs {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		fmt.Fprintf(&b, "%q: {\n", p)
+		for _, a := range sortedKeys(attrs[p]) {
+			fmt.Fprintf(&b, "%q: %s,\n", a, rangeLiteral(attrs[p][a]))
+		}
+		fmt.Fprintf(&b, "},\n")
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	return format.Source(b.Bytes())
+}
+
+func sortedKeys(m map[string]versionRange) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func rangeLiteral(vr versionRange) string {
+	var fields []string
+	if vr.since != "" {
+		fields = append(fields, fmt.Sprintf("since: %q", vr.since))
+	}
+	if vr.removed != "" {
+		fields = append(fields, fmt.Sprintf("removed: %q", vr.removed))
+	}
+
+	return "{" + strings.Join(fields, ", ") + "}"
+}